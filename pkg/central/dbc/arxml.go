@@ -0,0 +1,362 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"go.einride.tech/can/pkg/dbc"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// arxmlElement is an element of the AUTOSAR XML file, the ARXML schema is too large to be decoded to specific types
+type arxmlElement struct {
+	XMLName  xml.Name
+	Children []*arxmlElement `xml:",any"`
+	Text     string          `xml:",chardata"`
+}
+
+// child returns the first descendant element following the path of element names
+func (e *arxmlElement) child(path ...string) *arxmlElement {
+	element := e
+	for _, name := range path {
+		var next *arxmlElement
+		for _, child := range element.Children {
+			if child.XMLName.Local == name {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		element = next
+	}
+	return element
+}
+
+// text returns the trimmed text of the descendant element following the path of element names
+func (e *arxmlElement) text(path ...string) string {
+	if element := e.child(path...); element != nil {
+		return strings.TrimSpace(element.Text)
+	}
+	return ""
+}
+
+// find returns the first element of the name in the subtree of the element
+func (e *arxmlElement) find(name string) *arxmlElement {
+	for _, child := range e.Children {
+		if child.XMLName.Local == name {
+			return child
+		}
+		if element := child.find(name); element != nil {
+			return element
+		}
+	}
+	return nil
+}
+
+// findAll returns all elements of the name in the subtree of the element
+func (e *arxmlElement) findAll(name string) []*arxmlElement {
+	var elements []*arxmlElement
+	for _, child := range e.Children {
+		if child.XMLName.Local == name {
+			elements = append(elements, child)
+		}
+		elements = append(elements, child.findAll(name)...)
+	}
+	return elements
+}
+
+// arxmlModel resolves the references of the ARXML elements, which are the paths of the SHORT-NAMEs of the packages
+// and elements, e.g. /Cluster/CAN1/Frame1
+type arxmlModel struct {
+	elements map[string]*arxmlElement
+	parents  map[*arxmlElement]*arxmlElement
+}
+
+func newARXMLModel(root *arxmlElement) *arxmlModel {
+	model := &arxmlModel{
+		elements: make(map[string]*arxmlElement),
+		parents:  make(map[*arxmlElement]*arxmlElement),
+	}
+	model.index(root, "")
+	return model
+}
+
+func (m *arxmlModel) index(element *arxmlElement, path string) {
+	if shortName := element.text("SHORT-NAME"); shortName != "" {
+		path = path + "/" + shortName
+		m.elements[path] = element
+	}
+	for _, child := range element.Children {
+		m.parents[child] = element
+		m.index(child, path)
+	}
+}
+
+// ref returns the element referenced by the text of the descendant element following the path of element names
+func (m *arxmlModel) ref(e *arxmlElement, path ...string) *arxmlElement {
+	if e == nil {
+		return nil
+	}
+	return m.elements[e.text(path...)]
+}
+
+// ancestor returns the closest ancestor element of the name
+func (m *arxmlModel) ancestor(e *arxmlElement, name string) *arxmlElement {
+	for parent := m.parents[e]; parent != nil; parent = m.parents[parent] {
+		if parent.XMLName.Local == name {
+			return parent
+		}
+	}
+	return nil
+}
+
+// CompileARXML compiles the CAN frames of the AUTOSAR 4 system description to the CAN database
+// The frames are compiled from the CAN-FRAME-TRIGGERINGs and their I-SIGNAL-I-PDUs, the multiplexed and container PDUs
+// are skipped with warnings
+func CompileARXML(data []byte) (*CompileResult, error) {
+	var root arxmlElement
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to parse ARXML file: %w", err)
+	}
+	model := newARXMLModel(&root)
+
+	c := newCompiler()
+	for _, ecu := range root.findAll("ECU-INSTANCE") {
+		c.db.Nodes = append(c.db.Nodes, &descriptor.Node{Name: ecu.text("SHORT-NAME")})
+	}
+	for _, triggering := range root.findAll("CAN-FRAME-TRIGGERING") {
+		message, err := c.arxmlMessage(model, triggering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile ARXML frame triggering '%s': %w", triggering.text("SHORT-NAME"), err)
+		}
+		if message != nil {
+			c.db.Messages = append(c.db.Messages, message)
+		}
+	}
+	c.sortDescriptors()
+	c.checkDescriptors()
+	return c.result(), nil
+}
+
+func (c *compiler) arxmlMessage(model *arxmlModel, triggering *arxmlElement) (*descriptor.Message, error) {
+	frame := model.ref(triggering, "FRAME-REF")
+	if frame == nil {
+		c.addDiagnostic(DiagnosticMissingMessage, triggering.text("SHORT-NAME"), fmt.Sprintf("frame '%s' is not declared", triggering.text("FRAME-REF")))
+		return nil, nil
+	}
+	id, err := strconv.ParseUint(triggering.text("IDENTIFIER"), 0, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid identifier '%s': %w", triggering.text("IDENTIFIER"), err)
+	}
+	length, err := strconv.ParseUint(frame.text("FRAME-LENGTH"), 0, 8)
+	if err != nil || length > MaxDataSize {
+		return nil, fmt.Errorf("invalid frame length '%s'", frame.text("FRAME-LENGTH"))
+	}
+	message := &descriptor.Message{
+		Name:        frame.text("SHORT-NAME"),
+		ID:          uint32(id),
+		IsExtended:  triggering.text("CAN-ADDRESSING-MODE") == "EXTENDED" || id > 0x7FF,
+		Length:      uint8(length),
+		Description: frame.text("DESC", "L-2"),
+		SenderNode:  defaultNode,
+	}
+	if triggering.text("CAN-FRAME-TX-BEHAVIOR") == "CAN-FD" || triggering.text("CAN-FRAME-RX-BEHAVIOR") == "CAN-FD" {
+		c.extensions.message(message).FrameFormat = frameFormats[frameFormatIndex(message)]
+	}
+
+	var receivers []string
+	if portRefs := triggering.child("FRAME-PORT-REFS"); portRefs != nil {
+		for _, portRef := range portRefs.Children {
+			port := model.elements[strings.TrimSpace(portRef.Text)]
+			if port == nil {
+				continue
+			}
+			ecu := model.ancestor(port, "ECU-INSTANCE")
+			if ecu == nil {
+				continue
+			}
+			if port.text("COMMUNICATION-DIRECTION") == "OUT" {
+				message.SenderNode = ecu.text("SHORT-NAME")
+			} else {
+				receivers = append(receivers, ecu.text("SHORT-NAME"))
+			}
+		}
+	}
+
+	for _, mapping := range frame.findAll("PDU-TO-FRAME-MAPPING") {
+		pdu := model.ref(mapping, "PDU-REF")
+		if pdu == nil || pdu.XMLName.Local != "I-SIGNAL-I-PDU" {
+			c.addDiagnostic(DiagnosticInvalidDefinition, message.Name, fmt.Sprintf("PDU '%s' is not an I-SIGNAL-I-PDU", mapping.text("PDU-REF")))
+			continue
+		}
+		pduStart, err := strconv.ParseUint(mapping.text("START-POSITION"), 0, 16)
+		if err != nil && mapping.text("START-POSITION") != "" {
+			return nil, fmt.Errorf("invalid PDU start position '%s': %w", mapping.text("START-POSITION"), err)
+		}
+		setARXMLTiming(message, pdu)
+		for _, signalMapping := range pdu.findAll("I-SIGNAL-TO-I-PDU-MAPPING") {
+			if err := c.arxmlSignal(model, message, signalMapping, uint16(pduStart), receivers); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return message, nil
+}
+
+// setARXMLTiming sets the cycle time of the cyclic PDU or the event send type of the event controlled PDU
+func setARXMLTiming(message *descriptor.Message, pdu *arxmlElement) {
+	if cyclic := pdu.find("CYCLIC-TIMING"); cyclic != nil {
+		if seconds, err := strconv.ParseFloat(cyclic.text("TIME-PERIOD", "VALUE"), 64); err == nil {
+			message.CycleTime = time.Duration(seconds * float64(time.Second))
+			message.SendType = descriptor.SendTypeCyclic
+			return
+		}
+	}
+	if pdu.find("EVENT-CONTROLLED-TIMING") != nil {
+		message.SendType = descriptor.SendTypeEvent
+	}
+}
+
+// arxmlSignal adds the signal of the I-SIGNAL-TO-I-PDU-MAPPING to the message, the start position is the least
+// significant bit of the little endian signal and the most significant bit of the big endian signal
+func (c *compiler) arxmlSignal(model *arxmlModel, message *descriptor.Message, mapping *arxmlElement, pduStart uint16, receivers []string) error {
+	iSignal := model.ref(mapping, "I-SIGNAL-REF")
+	if iSignal == nil {
+		if mapping.text("I-SIGNAL-REF") != "" {
+			c.addDiagnostic(DiagnosticMissingSignal, message.Name, fmt.Sprintf("signal '%s' is not declared", mapping.text("I-SIGNAL-REF")))
+		}
+		return nil
+	}
+	position, err := strconv.ParseUint(mapping.text("START-POSITION"), 0, 16)
+	if err != nil {
+		return fmt.Errorf("invalid start position '%s' of signal '%s': %w", mapping.text("START-POSITION"), iSignal.text("SHORT-NAME"), err)
+	}
+	length, err := strconv.ParseUint(iSignal.text("LENGTH"), 0, 8)
+	if err != nil || length == 0 || length > 64 {
+		return fmt.Errorf("invalid length '%s' of signal '%s'", iSignal.text("LENGTH"), iSignal.text("SHORT-NAME"))
+	}
+	systemSignal := model.ref(iSignal, "SYSTEM-SIGNAL-REF")
+	signal := &descriptor.Signal{
+		Name:          iSignal.text("SHORT-NAME"),
+		IsBigEndian:   mapping.text("PACKING-BYTE-ORDER") == "MOST-SIGNIFICANT-BYTE-FIRST",
+		Length:        uint8(length),
+		Scale:         1,
+		ReceiverNodes: receivers,
+	}
+	start := uint64(pduStart) + position
+	signal.Start = uint8(start) // #nosec G115
+	if start > uint64(^uint8(0)) {
+		c.extensions.signal(signal).StartBit = uint16(start) // #nosec G115
+	}
+	if systemSignal != nil {
+		signal.Description = systemSignal.text("DESC", "L-2")
+	}
+	if initValue := iSignal.find("INIT-VALUE"); initValue != nil {
+		if value, err := strconv.ParseFloat(initValue.text("NUMERICAL-VALUE-SPECIFICATION", "VALUE"), 64); err == nil {
+			signal.DefaultValue = int(value)
+		}
+	}
+
+	if baseType := model.ref(iSignal.find("BASE-TYPE-REF")); baseType != nil {
+		switch baseType.text("BASE-TYPE-ENCODING") {
+		case "2C":
+			signal.IsSigned = true
+		case "IEEE754":
+			switch signal.Length {
+			case 32:
+				signal.IsFloat = true
+				c.extensions.signal(signal).ValueType = dbc.SignalValueTypeFloat32
+			case 64:
+				signal.IsFloat = true
+				c.extensions.signal(signal).ValueType = dbc.SignalValueTypeFloat64
+			}
+		}
+	}
+
+	compuMethod := model.ref(iSignal.find("COMPU-METHOD-REF"))
+	if compuMethod == nil && systemSignal != nil {
+		compuMethod = model.ref(systemSignal.find("COMPU-METHOD-REF"))
+	}
+	if compuMethod != nil {
+		setARXMLCompuMethod(signal, compuMethod)
+		if unit := model.ref(compuMethod, "UNIT-REF"); unit != nil {
+			signal.Unit = unit.text("DISPLAY-NAME")
+			if signal.Unit == "" {
+				signal.Unit = unit.text("SHORT-NAME")
+			}
+		}
+	}
+	message.Signals = append(message.Signals, signal)
+	return nil
+}
+
+// setARXMLCompuMethod sets the scale, offset and limits of the linear COMPU-SCALE, and the value descriptions of the
+// text table COMPU-SCALEs
+func setARXMLCompuMethod(signal *descriptor.Signal, compuMethod *arxmlElement) {
+	for _, scale := range compuMethod.findAll("COMPU-SCALE") {
+		lower, lowerErr := strconv.ParseFloat(scale.text("LOWER-LIMIT"), 64)
+		upper, upperErr := strconv.ParseFloat(scale.text("UPPER-LIMIT"), 64)
+		if text := scale.text("COMPU-CONST", "VT"); text != "" {
+			if lowerErr == nil {
+				signal.ValueDescriptions = append(signal.ValueDescriptions, &descriptor.ValueDescription{
+					Value:       int64(lower),
+					Description: text,
+				})
+			}
+			continue
+		}
+		coefficients := scale.child("COMPU-RATIONAL-COEFFS")
+		if coefficients == nil {
+			continue
+		}
+		var numerator []float64
+		if element := coefficients.child("COMPU-NUMERATOR"); element != nil {
+			for _, v := range element.Children {
+				if value, err := strconv.ParseFloat(strings.TrimSpace(v.Text), 64); err == nil {
+					numerator = append(numerator, value)
+				}
+			}
+		}
+		denominator, err := strconv.ParseFloat(coefficients.text("COMPU-DENOMINATOR", "V"), 64)
+		if err != nil || denominator == 0 {
+			denominator = 1
+		}
+		if len(numerator) == 2 {
+			signal.Offset = numerator[0] / denominator
+			signal.Scale = numerator[1] / denominator
+		}
+		if lowerErr == nil && upperErr == nil {
+			signal.Min = lower*signal.Scale + signal.Offset
+			signal.Max = upper*signal.Scale + signal.Offset
+		}
+	}
+}
+
+// ConvertARXMLtoProfile converts the frames of the ARXML file selected by the options to DeviceProfile DTOs
+func ConvertARXMLtoProfile(data []byte, options ImportOptions) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
+	compileResult, err := CompileARXML(data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to compile ARXML file", err)
+	}
+	return convertDatabaseToProfile(compileResult, options)
+}
+
+// ConvertARXMLtoDevice converts the frames of the ARXML file selected by the options to Device DTOs
+func ConvertARXMLtoDevice(data []byte, args map[string]string, options ImportOptions) (Converter[[]edgexDtos.Device], errors.EdgeX) {
+	compileResult, err := CompileARXML(data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to compile ARXML file", err)
+	}
+	return convertDatabaseToDevice(compileResult, args, options)
+}
@@ -0,0 +1,346 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"testing"
+	"time"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const testARXML = `<?xml version="1.0" encoding="UTF-8"?>
+<AUTOSAR xmlns="http://autosar.org/schema/r4.0">
+  <AR-PACKAGES>
+    <AR-PACKAGE>
+      <SHORT-NAME>System</SHORT-NAME>
+      <ELEMENTS>
+        <ECU-INSTANCE>
+          <SHORT-NAME>Engine</SHORT-NAME>
+          <CONNECTORS>
+            <CAN-COMMUNICATION-CONNECTOR>
+              <SHORT-NAME>EngineConnector</SHORT-NAME>
+              <ECU-COMM-PORT-INSTANCES>
+                <FRAME-PORT>
+                  <SHORT-NAME>EngineDataOut</SHORT-NAME>
+                  <COMMUNICATION-DIRECTION>OUT</COMMUNICATION-DIRECTION>
+                </FRAME-PORT>
+              </ECU-COMM-PORT-INSTANCES>
+            </CAN-COMMUNICATION-CONNECTOR>
+          </CONNECTORS>
+        </ECU-INSTANCE>
+        <ECU-INSTANCE>
+          <SHORT-NAME>Dashboard</SHORT-NAME>
+          <CONNECTORS>
+            <CAN-COMMUNICATION-CONNECTOR>
+              <SHORT-NAME>DashboardConnector</SHORT-NAME>
+              <ECU-COMM-PORT-INSTANCES>
+                <FRAME-PORT>
+                  <SHORT-NAME>EngineDataIn</SHORT-NAME>
+                  <COMMUNICATION-DIRECTION>IN</COMMUNICATION-DIRECTION>
+                </FRAME-PORT>
+              </ECU-COMM-PORT-INSTANCES>
+            </CAN-COMMUNICATION-CONNECTOR>
+          </CONNECTORS>
+        </ECU-INSTANCE>
+        <CAN-CLUSTER>
+          <SHORT-NAME>CAN1</SHORT-NAME>
+          <CAN-CLUSTER-VARIANTS>
+            <CAN-CLUSTER-CONDITIONAL>
+              <PHYSICAL-CHANNELS>
+                <CAN-PHYSICAL-CHANNEL>
+                  <SHORT-NAME>Channel</SHORT-NAME>
+                  <FRAME-TRIGGERINGS>
+                    <CAN-FRAME-TRIGGERING>
+                      <SHORT-NAME>EngineDataTriggering</SHORT-NAME>
+                      <FRAME-PORT-REFS>
+                        <FRAME-PORT-REF DEST="FRAME-PORT">/System/Engine/EngineConnector/EngineDataOut</FRAME-PORT-REF>
+                        <FRAME-PORT-REF DEST="FRAME-PORT">/System/Dashboard/DashboardConnector/EngineDataIn</FRAME-PORT-REF>
+                      </FRAME-PORT-REFS>
+                      <FRAME-REF DEST="CAN-FRAME">/Frames/EngineData</FRAME-REF>
+                      <CAN-ADDRESSING-MODE>STANDARD</CAN-ADDRESSING-MODE>
+                      <IDENTIFIER>291</IDENTIFIER>
+                    </CAN-FRAME-TRIGGERING>
+                    <CAN-FRAME-TRIGGERING>
+                      <SHORT-NAME>DiagnosticsTriggering</SHORT-NAME>
+                      <FRAME-REF DEST="CAN-FRAME">/Frames/Diagnostics</FRAME-REF>
+                      <CAN-ADDRESSING-MODE>EXTENDED</CAN-ADDRESSING-MODE>
+                      <CAN-FRAME-TX-BEHAVIOR>CAN-FD</CAN-FRAME-TX-BEHAVIOR>
+                      <IDENTIFIER>0x18FEF100</IDENTIFIER>
+                    </CAN-FRAME-TRIGGERING>
+                    <CAN-FRAME-TRIGGERING>
+                      <SHORT-NAME>MissingTriggering</SHORT-NAME>
+                      <FRAME-REF DEST="CAN-FRAME">/Frames/Missing</FRAME-REF>
+                      <IDENTIFIER>1</IDENTIFIER>
+                    </CAN-FRAME-TRIGGERING>
+                  </FRAME-TRIGGERINGS>
+                </CAN-PHYSICAL-CHANNEL>
+              </PHYSICAL-CHANNELS>
+            </CAN-CLUSTER-CONDITIONAL>
+          </CAN-CLUSTER-VARIANTS>
+        </CAN-CLUSTER>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>Frames</SHORT-NAME>
+      <ELEMENTS>
+        <CAN-FRAME>
+          <SHORT-NAME>EngineData</SHORT-NAME>
+          <DESC><L-2 L="EN">Engine data</L-2></DESC>
+          <FRAME-LENGTH>8</FRAME-LENGTH>
+          <PDU-TO-FRAME-MAPPINGS>
+            <PDU-TO-FRAME-MAPPING>
+              <SHORT-NAME>EngineDataMapping</SHORT-NAME>
+              <PDU-REF DEST="I-SIGNAL-I-PDU">/PDUs/EngineDataPdu</PDU-REF>
+              <START-POSITION>0</START-POSITION>
+            </PDU-TO-FRAME-MAPPING>
+          </PDU-TO-FRAME-MAPPINGS>
+        </CAN-FRAME>
+        <CAN-FRAME>
+          <SHORT-NAME>Diagnostics</SHORT-NAME>
+          <FRAME-LENGTH>64</FRAME-LENGTH>
+          <PDU-TO-FRAME-MAPPINGS>
+            <PDU-TO-FRAME-MAPPING>
+              <SHORT-NAME>DiagnosticsMapping</SHORT-NAME>
+              <PDU-REF DEST="MULTIPLEXED-I-PDU">/PDUs/DiagnosticsPdu</PDU-REF>
+            </PDU-TO-FRAME-MAPPING>
+          </PDU-TO-FRAME-MAPPINGS>
+        </CAN-FRAME>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>PDUs</SHORT-NAME>
+      <ELEMENTS>
+        <I-SIGNAL-I-PDU>
+          <SHORT-NAME>EngineDataPdu</SHORT-NAME>
+          <LENGTH>8</LENGTH>
+          <I-PDU-TIMING-SPECIFICATIONS>
+            <I-PDU-TIMING>
+              <TRANSMISSION-MODE-DECLARATION>
+                <TRANSMISSION-MODE-TRUE-TIMING>
+                  <CYCLIC-TIMING>
+                    <TIME-PERIOD><VALUE>0.05</VALUE></TIME-PERIOD>
+                  </CYCLIC-TIMING>
+                </TRANSMISSION-MODE-TRUE-TIMING>
+              </TRANSMISSION-MODE-DECLARATION>
+            </I-PDU-TIMING>
+          </I-PDU-TIMING-SPECIFICATIONS>
+          <I-SIGNAL-TO-PDU-MAPPINGS>
+            <I-SIGNAL-TO-I-PDU-MAPPING>
+              <SHORT-NAME>SpeedMapping</SHORT-NAME>
+              <I-SIGNAL-REF DEST="I-SIGNAL">/Signals/Speed</I-SIGNAL-REF>
+              <PACKING-BYTE-ORDER>MOST-SIGNIFICANT-BYTE-LAST</PACKING-BYTE-ORDER>
+              <START-POSITION>0</START-POSITION>
+            </I-SIGNAL-TO-I-PDU-MAPPING>
+            <I-SIGNAL-TO-I-PDU-MAPPING>
+              <SHORT-NAME>TemperatureMapping</SHORT-NAME>
+              <I-SIGNAL-REF DEST="I-SIGNAL">/Signals/Temperature</I-SIGNAL-REF>
+              <PACKING-BYTE-ORDER>MOST-SIGNIFICANT-BYTE-FIRST</PACKING-BYTE-ORDER>
+              <START-POSITION>23</START-POSITION>
+            </I-SIGNAL-TO-I-PDU-MAPPING>
+            <I-SIGNAL-TO-I-PDU-MAPPING>
+              <SHORT-NAME>GearMapping</SHORT-NAME>
+              <I-SIGNAL-REF DEST="I-SIGNAL">/Signals/Gear</I-SIGNAL-REF>
+              <PACKING-BYTE-ORDER>MOST-SIGNIFICANT-BYTE-LAST</PACKING-BYTE-ORDER>
+              <START-POSITION>32</START-POSITION>
+            </I-SIGNAL-TO-I-PDU-MAPPING>
+          </I-SIGNAL-TO-PDU-MAPPINGS>
+        </I-SIGNAL-I-PDU>
+        <MULTIPLEXED-I-PDU>
+          <SHORT-NAME>DiagnosticsPdu</SHORT-NAME>
+        </MULTIPLEXED-I-PDU>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>Signals</SHORT-NAME>
+      <ELEMENTS>
+        <I-SIGNAL>
+          <SHORT-NAME>Speed</SHORT-NAME>
+          <INIT-VALUE>
+            <NUMERICAL-VALUE-SPECIFICATION><VALUE>10</VALUE></NUMERICAL-VALUE-SPECIFICATION>
+          </INIT-VALUE>
+          <LENGTH>16</LENGTH>
+          <SYSTEM-SIGNAL-REF DEST="SYSTEM-SIGNAL">/SystemSignals/Speed</SYSTEM-SIGNAL-REF>
+        </I-SIGNAL>
+        <I-SIGNAL>
+          <SHORT-NAME>Temperature</SHORT-NAME>
+          <LENGTH>16</LENGTH>
+          <NETWORK-REPRESENTATION-PROPS>
+            <SW-DATA-DEF-PROPS-VARIANTS>
+              <SW-DATA-DEF-PROPS-CONDITIONAL>
+                <BASE-TYPE-REF DEST="SW-BASE-TYPE">/BaseTypes/sint16</BASE-TYPE-REF>
+              </SW-DATA-DEF-PROPS-CONDITIONAL>
+            </SW-DATA-DEF-PROPS-VARIANTS>
+          </NETWORK-REPRESENTATION-PROPS>
+          <SYSTEM-SIGNAL-REF DEST="SYSTEM-SIGNAL">/SystemSignals/Temperature</SYSTEM-SIGNAL-REF>
+        </I-SIGNAL>
+        <I-SIGNAL>
+          <SHORT-NAME>Gear</SHORT-NAME>
+          <LENGTH>4</LENGTH>
+          <SYSTEM-SIGNAL-REF DEST="SYSTEM-SIGNAL">/SystemSignals/Gear</SYSTEM-SIGNAL-REF>
+        </I-SIGNAL>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>SystemSignals</SHORT-NAME>
+      <ELEMENTS>
+        <SYSTEM-SIGNAL>
+          <SHORT-NAME>Speed</SHORT-NAME>
+          <DESC><L-2 L="EN">Vehicle speed</L-2></DESC>
+          <PHYSICAL-PROPS>
+            <SW-DATA-DEF-PROPS-VARIANTS>
+              <SW-DATA-DEF-PROPS-CONDITIONAL>
+                <COMPU-METHOD-REF DEST="COMPU-METHOD">/CompuMethods/Speed</COMPU-METHOD-REF>
+              </SW-DATA-DEF-PROPS-CONDITIONAL>
+            </SW-DATA-DEF-PROPS-VARIANTS>
+          </PHYSICAL-PROPS>
+        </SYSTEM-SIGNAL>
+        <SYSTEM-SIGNAL>
+          <SHORT-NAME>Temperature</SHORT-NAME>
+        </SYSTEM-SIGNAL>
+        <SYSTEM-SIGNAL>
+          <SHORT-NAME>Gear</SHORT-NAME>
+          <PHYSICAL-PROPS>
+            <SW-DATA-DEF-PROPS-VARIANTS>
+              <SW-DATA-DEF-PROPS-CONDITIONAL>
+                <COMPU-METHOD-REF DEST="COMPU-METHOD">/CompuMethods/Gear</COMPU-METHOD-REF>
+              </SW-DATA-DEF-PROPS-CONDITIONAL>
+            </SW-DATA-DEF-PROPS-VARIANTS>
+          </PHYSICAL-PROPS>
+        </SYSTEM-SIGNAL>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>CompuMethods</SHORT-NAME>
+      <ELEMENTS>
+        <COMPU-METHOD>
+          <SHORT-NAME>Speed</SHORT-NAME>
+          <CATEGORY>LINEAR</CATEGORY>
+          <UNIT-REF DEST="UNIT">/Units/KilometerPerHour</UNIT-REF>
+          <COMPU-INTERNAL-TO-PHYS>
+            <COMPU-SCALES>
+              <COMPU-SCALE>
+                <LOWER-LIMIT>0</LOWER-LIMIT>
+                <UPPER-LIMIT>65535</UPPER-LIMIT>
+                <COMPU-RATIONAL-COEFFS>
+                  <COMPU-NUMERATOR><V>0</V><V>1</V></COMPU-NUMERATOR>
+                  <COMPU-DENOMINATOR><V>10</V></COMPU-DENOMINATOR>
+                </COMPU-RATIONAL-COEFFS>
+              </COMPU-SCALE>
+            </COMPU-SCALES>
+          </COMPU-INTERNAL-TO-PHYS>
+        </COMPU-METHOD>
+        <COMPU-METHOD>
+          <SHORT-NAME>Gear</SHORT-NAME>
+          <CATEGORY>TEXTTABLE</CATEGORY>
+          <COMPU-INTERNAL-TO-PHYS>
+            <COMPU-SCALES>
+              <COMPU-SCALE>
+                <LOWER-LIMIT>1</LOWER-LIMIT>
+                <UPPER-LIMIT>1</UPPER-LIMIT>
+                <COMPU-CONST><VT>Drive</VT></COMPU-CONST>
+              </COMPU-SCALE>
+              <COMPU-SCALE>
+                <LOWER-LIMIT>0</LOWER-LIMIT>
+                <UPPER-LIMIT>0</UPPER-LIMIT>
+                <COMPU-CONST><VT>Park</VT></COMPU-CONST>
+              </COMPU-SCALE>
+            </COMPU-SCALES>
+          </COMPU-INTERNAL-TO-PHYS>
+        </COMPU-METHOD>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>Units</SHORT-NAME>
+      <ELEMENTS>
+        <UNIT>
+          <SHORT-NAME>KilometerPerHour</SHORT-NAME>
+          <DISPLAY-NAME>km/h</DISPLAY-NAME>
+        </UNIT>
+      </ELEMENTS>
+    </AR-PACKAGE>
+    <AR-PACKAGE>
+      <SHORT-NAME>BaseTypes</SHORT-NAME>
+      <ELEMENTS>
+        <SW-BASE-TYPE>
+          <SHORT-NAME>sint16</SHORT-NAME>
+          <BASE-TYPE-ENCODING>2C</BASE-TYPE-ENCODING>
+        </SW-BASE-TYPE>
+      </ELEMENTS>
+    </AR-PACKAGE>
+  </AR-PACKAGES>
+</AUTOSAR>`
+
+func TestCompileARXML(t *testing.T) {
+	result, err := CompileARXML([]byte(testARXML))
+	require.NoError(t, err)
+	db := result.Database
+	assert.Len(t, db.Nodes, 2)
+	require.Len(t, db.Messages, 2)
+
+	engineData, diagnostics := db.Messages[0], db.Messages[1]
+	assert.Equal(t, "EngineData", engineData.Name)
+	assert.Equal(t, uint32(291), engineData.ID)
+	assert.False(t, engineData.IsExtended)
+	assert.Equal(t, uint8(8), engineData.Length)
+	assert.Equal(t, "Engine data", engineData.Description)
+	assert.Equal(t, "Engine", engineData.SenderNode)
+	assert.Equal(t, 50*time.Millisecond, engineData.CycleTime)
+	assert.Equal(t, descriptor.SendTypeCyclic, engineData.SendType)
+
+	speed, ok := db.Signal(engineData.ID, "Speed")
+	require.True(t, ok)
+	assert.Equal(t, uint8(16), speed.Length)
+	assert.Equal(t, 0.1, speed.Scale)
+	assert.Equal(t, 6553.5, speed.Max)
+	assert.Equal(t, "km/h", speed.Unit)
+	assert.Equal(t, "Vehicle speed", speed.Description)
+	assert.Equal(t, 10, speed.DefaultValue)
+	assert.Equal(t, []string{"Dashboard"}, speed.ReceiverNodes)
+	temperature, ok := db.Signal(engineData.ID, "Temperature")
+	require.True(t, ok)
+	assert.True(t, temperature.IsBigEndian)
+	assert.True(t, temperature.IsSigned)
+	assert.Equal(t, uint8(23), temperature.Start)
+	gear, ok := db.Signal(engineData.ID, "Gear")
+	require.True(t, ok)
+	require.Len(t, gear.ValueDescriptions, 2)
+	assert.Equal(t, "Park", gear.ValueDescriptions[0].Description)
+
+	assert.Equal(t, "Diagnostics", diagnostics.Name)
+	assert.True(t, diagnostics.IsExtended)
+	assert.Equal(t, uint32(0x18FEF100), diagnostics.ID)
+	assert.True(t, result.Extensions.IsFD(diagnostics))
+	assert.Empty(t, diagnostics.Signals)
+
+	codes := make([]DiagnosticCode, len(result.Diagnostics))
+	for i, diagnostic := range result.Diagnostics {
+		codes[i] = diagnostic.Code
+	}
+	assert.Equal(t, []DiagnosticCode{DiagnosticInvalidDefinition, DiagnosticMissingMessage}, codes)
+}
+
+func TestConvertARXML(t *testing.T) {
+	profileConverter, err := ConvertARXMLtoProfile([]byte(testARXML), ImportOptions{})
+	require.NoError(t, err)
+	profiles := profileConverter.GetDTOs()
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "EngineData", profiles[0].Name)
+	require.Len(t, profiles[0].DeviceResources, 3)
+	assert.Equal(t, edgexCommon.ValueTypeFloat64, profiles[0].DeviceResources[0].Properties.ValueType)
+
+	deviceConverter, err := ConvertARXMLtoDevice([]byte(testARXML), map[string]string{ServiceName: "device-can"}, ImportOptions{})
+	require.NoError(t, err)
+	devices := deviceConverter.GetDTOs()
+	require.Len(t, devices, 2)
+	assert.Equal(t, "291", devices[0].Protocols[Canbus][ID])
+	assert.Equal(t, "50ms", devices[0].AutoEvents[0].Interval)
+	assert.Equal(t, "true", devices[1].Protocols[Canbus][FD])
+
+	_, err = ConvertARXMLtoProfile([]byte("<AUTOSAR>"), ImportOptions{})
+	require.Error(t, err)
+}
@@ -8,6 +8,8 @@ package dbc
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.einride.tech/can/pkg/dbc"
@@ -17,26 +19,57 @@ import (
 type CompileResult struct {
 	Database *descriptor.Database
 	Warnings []error
+	// Extensions has the CAN FD and extended signal definitions which the Database can't describe
+	Extensions *Extensions
+	// Diagnostics are the line-referenced compile warnings and the checks of the signal layouts and nodes
+	Diagnostics []Diagnostic
+
+	messageLines map[*descriptor.Message]int
 }
 
 func Compile(sourceFile string, data []byte) (result *CompileResult, err error) {
+	data, multiplexerLines := preprocess(data)
 	p := dbc.NewParser(sourceFile, data)
 	if err := p.Parse(); err != nil {
 		return nil, fmt.Errorf("failed to parse DBC source file: %w", err)
 	}
-	defs := p.Defs()
-	c := &compiler{
-		db:   &descriptor.Database{},
-		defs: defs,
-	}
+	c := newCompiler()
+	c.defs = p.Defs()
+	c.lines = strings.Split(string(data), "\n")
+	c.multiplexerLines = multiplexerLines
 	c.collectDescriptors()
+	c.addAttributeDefaults()
 	c.addMetadata()
 	c.sortDescriptors()
-	return &CompileResult{Database: c.db, Warnings: c.warnings}, nil
+	c.checkDescriptors()
+	return c.result(), nil
+}
+
+// newCompiler returns the compiler of an empty database, which is also used to build the databases of the KCD and
+// ARXML files
+func newCompiler() *compiler {
+	return &compiler{
+		db:           &descriptor.Database{},
+		extensions:   newExtensions(),
+		attributes:   make(map[dbc.Identifier]*dbc.AttributeDef),
+		messageLines: make(map[*descriptor.Message]int),
+		signalLines:  make(map[*descriptor.Signal]int),
+	}
+}
+
+func (c *compiler) result() *CompileResult {
+	return &CompileResult{
+		Database:     c.db,
+		Warnings:     c.warnings,
+		Extensions:   c.extensions,
+		Diagnostics:  c.diagnostics,
+		messageLines: c.messageLines,
+	}
 }
 
 type compileError struct {
 	def    dbc.Def
+	code   DiagnosticCode
 	reason string
 }
 
@@ -45,13 +78,28 @@ func (e *compileError) Error() string {
 }
 
 type compiler struct {
-	db       *descriptor.Database
-	defs     []dbc.Def
-	warnings []error
+	db               *descriptor.Database
+	defs             []dbc.Def
+	warnings         []error
+	extensions       *Extensions
+	lines            []string
+	multiplexerLines map[int]bool
+	attributes       map[dbc.Identifier]*dbc.AttributeDef
+	diagnostics      []Diagnostic
+	messageLines     map[*descriptor.Message]int
+	signalLines      map[*descriptor.Signal]int
 }
 
 func (c *compiler) addWarning(warning error) {
 	c.warnings = append(c.warnings, warning)
+	if warning, ok := warning.(*compileError); ok {
+		c.diagnostics = append(c.diagnostics, Diagnostic{
+			Line:     warning.def.Position().Line,
+			Severity: SeverityWarning,
+			Code:     warning.code,
+			Reason:   warning.reason,
+		})
+	}
 }
 
 func (c *compiler) collectDescriptors() {
@@ -89,17 +137,120 @@ func (c *compiler) collectDescriptors() {
 				for _, receiver := range signalDef.Receivers {
 					signal.ReceiverNodes = append(signal.ReceiverNodes, string(receiver))
 				}
+				if signalDef.StartBit > uint64(^uint8(0)) {
+					c.extensions.signal(signal).StartBit = uint16(signalDef.StartBit)
+				}
+				if c.multiplexerLines[signalDef.Pos.Line] {
+					// extended multiplexing: the multiplexed signal is the multiplexer of other signals
+					signal.IsMultiplexer = true
+				}
 				message.Signals = append(message.Signals, signal)
+				c.signalLines[signal] = signalDef.Pos.Line
 			}
 			c.db.Messages = append(c.db.Messages, message)
+			c.messageLines[message] = def.Pos.Line
 		case *dbc.NodesDef:
 			for _, node := range def.NodeNames {
 				c.db.Nodes = append(c.db.Nodes, &descriptor.Node{Name: string(node)})
 			}
+		case *dbc.AttributeDef:
+			c.attributes[def.Name] = def
+		}
+	}
+}
+
+// addAttributeDefaults sets the BA_DEF_DEF_ default values of the message and signal attributes, which are
+// overridden by the BA_ attribute values
+func (c *compiler) addAttributeDefaults() {
+	for _, def := range c.defs {
+		def, ok := def.(*dbc.AttributeDefaultValueDef)
+		if !ok {
+			continue
+		}
+		attributeDef, ok := c.attributes[def.AttributeName]
+		if !ok {
+			c.addWarning(&compileError{def: def, code: DiagnosticMissingAttribute, reason: "no declared attribute"})
+			continue
+		}
+		intValue := def.DefaultIntValue
+		if attributeDef.Type == dbc.AttributeValueTypeFloat {
+			intValue = int64(def.DefaultFloatValue)
+		}
+		switch attributeDef.ObjectType {
+		case dbc.ObjectTypeMessage:
+			for _, msg := range c.db.Messages {
+				c.setMessageAttribute(def, msg, def.AttributeName, intValue, def.DefaultStringValue)
+			}
+		case dbc.ObjectTypeSignal:
+			for _, msg := range c.db.Messages {
+				for _, sig := range msg.Signals {
+					c.setSignalAttribute(sig, def.AttributeName, intValue)
+				}
+			}
+		}
+	}
+}
+
+func (c *compiler) setMessageAttribute(def dbc.Def, msg *descriptor.Message, name dbc.Identifier, intValue int64, stringValue string) {
+	switch name {
+	case "GenMsgSendType":
+		if err := msg.SendType.UnmarshalString(stringValue); err != nil {
+			c.addWarning(&compileError{def: def, code: DiagnosticInvalidDefinition, reason: err.Error()})
 		}
+	case "GenMsgCycleTime":
+		msg.CycleTime = time.Duration(intValue) * time.Millisecond
+	case "GenMsgDelayTime":
+		msg.DelayTime = time.Duration(intValue) * time.Millisecond
+	case attributeVFrameFormat:
+		c.extensions.message(msg).FrameFormat = stringValue
 	}
 }
 
+func (c *compiler) setSignalAttribute(sig *descriptor.Signal, name dbc.Identifier, intValue int64) {
+	if name == "GenSigStartValue" {
+		sig.DefaultValue = int(intValue)
+	}
+}
+
+// addSignalMultiplexValue adds the extended multiplexing of the SG_MUL_VAL_ definition, which the DBC parser skips
+func (c *compiler) addSignalMultiplexValue(def *dbc.UnknownDef) {
+	if def.Pos.Line < 1 || def.Pos.Line > len(c.lines) {
+		return
+	}
+	matches := signalMultiplexValuePattern.FindStringSubmatch(c.lines[def.Pos.Line-1])
+	if matches == nil {
+		c.addWarning(&compileError{def: def, code: DiagnosticInvalidDefinition, reason: "invalid extended multiplexing"})
+		return
+	}
+	messageID, err := strconv.ParseUint(matches[1], 10, 32)
+	if err != nil {
+		c.addWarning(&compileError{def: def, code: DiagnosticInvalidDefinition, reason: err.Error()})
+		return
+	}
+	sig, ok := c.db.Signal(dbc.MessageID(messageID).ToCAN(), matches[2])
+	if !ok {
+		c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared signal"})
+		return
+	}
+	multiplexer, ok := c.db.Signal(dbc.MessageID(messageID).ToCAN(), matches[3])
+	if !ok || !multiplexer.IsMultiplexer {
+		c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared multiplexer signal"})
+		return
+	}
+	var ranges []MultiplexerRange
+	for _, rangeString := range strings.Split(matches[4], ",") {
+		multiplexerRange, err := parseMultiplexerRange(rangeString)
+		if err != nil {
+			c.addWarning(&compileError{def: def, code: DiagnosticInvalidDefinition, reason: err.Error()})
+			return
+		}
+		ranges = append(ranges, multiplexerRange)
+	}
+	extension := c.extensions.signal(sig)
+	extension.MultiplexerSwitch = multiplexer.Name
+	extension.MultiplexerRanges = ranges
+}
+
 func (c *compiler) addMetadata() {
 	for _, def := range c.defs {
 		switch def := def.(type) {
@@ -111,7 +262,7 @@ func (c *compiler) addMetadata() {
 				}
 				message, ok := c.db.Message(def.MessageID.ToCAN())
 				if !ok {
-					c.addWarning(&compileError{def: def, reason: "no declared message"})
+					c.addWarning(&compileError{def: def, code: DiagnosticMissingMessage, reason: "no declared message"})
 					continue
 				}
 				message.Description = def.Comment
@@ -121,14 +272,14 @@ func (c *compiler) addMetadata() {
 				}
 				signal, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 				if !ok {
-					c.addWarning(&compileError{def: def, reason: "no declared signal"})
+					c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared signal"})
 					continue
 				}
 				signal.Description = def.Comment
 			case dbc.ObjectTypeNetworkNode:
 				node, ok := c.db.Node(string(def.NodeName))
 				if !ok {
-					c.addWarning(&compileError{def: def, reason: "no declared node"})
+					c.addWarning(&compileError{def: def, code: DiagnosticMissingNode, reason: "no declared node"})
 					continue
 				}
 				node.Description = def.Comment
@@ -142,7 +293,7 @@ func (c *compiler) addMetadata() {
 			}
 			signal, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 			if !ok {
-				c.addWarning(&compileError{def: def, reason: "no declared signal"})
+				c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared signal"})
 				continue
 			}
 			for _, valueDescription := range def.ValueDescriptions {
@@ -156,33 +307,50 @@ func (c *compiler) addMetadata() {
 			case dbc.ObjectTypeMessage:
 				msg, ok := c.db.Message(def.MessageID.ToCAN())
 				if !ok {
-					c.addWarning(&compileError{def: def, reason: "no declared message"})
+					c.addWarning(&compileError{def: def, code: DiagnosticMissingMessage, reason: "no declared message"})
 					continue
 				}
-				switch def.AttributeName {
-				case "GenMsgSendType":
-					if err := msg.SendType.UnmarshalString(def.StringValue); err != nil {
-						c.addWarning(&compileError{def: def, reason: err.Error()})
-						continue
-					}
-				case "GenMsgCycleTime":
-					msg.CycleTime = time.Duration(def.IntValue) * time.Millisecond
-				case "GenMsgDelayTime":
-					msg.DelayTime = time.Duration(def.IntValue) * time.Millisecond
-				}
+				c.setMessageAttribute(def, msg, def.AttributeName, c.intValue(def), def.StringValue)
 			case dbc.ObjectTypeSignal:
 				sig, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 				if !ok {
-					c.addWarning(&compileError{def: def, reason: "no declared signal"})
+					c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared signal"})
+					continue
 				}
-				if def.AttributeName == "GenSigStartValue" {
-					sig.DefaultValue = int(def.IntValue)
+				c.setSignalAttribute(sig, def.AttributeName, c.intValue(def))
+			}
+		case *dbc.SignalValueTypeDef:
+			sig, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
+			if !ok {
+				c.addWarning(&compileError{def: def, code: DiagnosticMissingSignal, reason: "no declared signal"})
+				continue
+			}
+			switch def.SignalValueType {
+			case dbc.SignalValueTypeFloat32, dbc.SignalValueTypeFloat64:
+				if (def.SignalValueType == dbc.SignalValueTypeFloat32 && sig.Length != 32) ||
+					(def.SignalValueType == dbc.SignalValueTypeFloat64 && sig.Length != 64) {
+					c.addWarning(&compileError{def: def, code: DiagnosticInvalidDefinition, reason: "signal length doesn't match the float value type"})
+					continue
 				}
+				sig.IsFloat = true
+				c.extensions.signal(sig).ValueType = def.SignalValueType
+			}
+		case *dbc.UnknownDef:
+			if def.Keyword == keywordSignalMultiplexValue {
+				c.addSignalMultiplexValue(def)
 			}
 		}
 	}
 }
 
+// intValue returns the integer value of the INT, HEX and FLOAT attribute value
+func (c *compiler) intValue(def *dbc.AttributeValueForObjectDef) int64 {
+	if attributeDef, ok := c.attributes[def.AttributeName]; ok && attributeDef.Type == dbc.AttributeValueTypeFloat {
+		return int64(def.FloatValue)
+	}
+	return def.IntValue
+}
+
 func (c *compiler) sortDescriptors() {
 	// Sort nodes by name
 	sort.Slice(c.db.Nodes, func(i, j int) bool {
@@ -199,7 +367,7 @@ func (c *compiler) sortDescriptors() {
 			if m.Signals[j].MultiplexerValue < m.Signals[k].MultiplexerValue {
 				return true
 			}
-			return m.Signals[j].Start < m.Signals[k].Start
+			return c.extensions.StartBit(m.Signals[j]) < c.extensions.StartBit(m.Signals[k])
 		})
 		// Sort value descriptions by value
 		for _, s := range m.Signals {
@@ -1,23 +1,38 @@
-// Copyright (C) 2023 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package dbc
 
+import (
+	"time"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+)
+
 const (
 	ServiceName = "ServiceName"
 
-	Canbus          = "CANbus"
+	Canbus          = common.Canbus
 	J1939           = "J1939"
+	CAN             = "CAN"
 	Network         = "Network"
 	Standard        = "Standard"
-	ID              = "ID"
-	DataSize        = "DataSize"
+	ID              = common.CanbusID
+	DataSize        = common.CanbusDataSize
 	Sender          = "Sender"
 	PGN             = "PGN"
 	CommType        = "CommType"
 	CommTypeTCP     = "TCP"
-	Port            = "Port"
+	Port            = common.CanbusPort
 	NetType         = "NetType"
 	NetTypeEthernet = "Ethernet"
+	FD              = "FD"
+
+	Priority           = "Priority"
+	SourceAddress      = "SA"
+	DestinationAddress = "DA"
+	PDUFormat          = "PDUFormat"
+	PDUFormat1         = "PDU1"
+	PDUFormat2         = "PDU2"
 
 	BitStart      = "bitStart"
 	BitLen        = "bitLen"
@@ -26,8 +41,21 @@ const (
 	MuxSignal     = "muxSignal"
 	MuxNum        = "muxNum"
 	IsSigned      = "isSigned"
+	MultiPacket   = "multiPacket"
+	IsFloat       = "isFloat"
+	MuxSwitch     = "muxSwitch"
+	MuxRanges     = "muxRanges"
+	LabelValues   = "labelValues"
 
 	messageIDExtendedFlag = 0x80000000
 	j1939PGNOffset        = 8
 	j1939PGNMask          = 0x3FFFF
+	j1939PriorityOffset   = 26
+	j1939PriorityMask     = 0x7
+	j1939PFOffset         = 16
+	j1939PDU2MinPF        = 240
+	j1939MaxSingleFrame   = 8
+
+	// defaultEventInterval is the AutoEvent interval of the event messages without cycle time and delay time
+	defaultEventInterval = time.Second
 )
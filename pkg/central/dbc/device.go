@@ -5,10 +5,13 @@ package dbc
 import (
 	"strconv"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"go.einride.tech/can/pkg/descriptor"
 )
 
 // deviceDBC stores the compiled DBC database, conversion arguments, and the converted Device DTOs
@@ -16,66 +19,121 @@ type deviceDBC struct {
 	compileResult  *CompileResult
 	args           map[string]string
 	devices        []edgexDtos.Device
+	schedules      map[string][]xrtmodels.Schedule // schedules defines the XRT Schedules keyed by device name
 	validateErrors map[string]error
+	diagnostics    []Diagnostic
+	options        ImportOptions
 }
 
-func newDeviceDBC(data []byte, args map[string]string) (Converter[[]edgexDtos.Device], errors.EdgeX) {
-	compileResult, err := Compile("", data)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to compile DBC file", err)
-	}
-
+func newDeviceDBC(compileResult *CompileResult, args map[string]string, options ImportOptions) Converter[[]edgexDtos.Device] {
 	return &deviceDBC{
 		compileResult:  compileResult,
 		args:           args,
 		devices:        make([]edgexDtos.Device, 0),
+		schedules:      make(map[string][]xrtmodels.Schedule),
 		validateErrors: make(map[string]error, len(compileResult.Database.Messages)),
-	}, nil
+		diagnostics:    append([]Diagnostic(nil), compileResult.Diagnostics...),
+		options:        options,
+	}
 }
 
 // ConvertToDTO parses the DBC messages and converts them to Device DTOs
 func (dDBC *deviceDBC) ConvertToDTO() errors.EdgeX {
-	for _, m := range dDBC.compileResult.Database.Messages {
-		deviceDTO := edgexDtos.Device{
-			Name:           m.Name,
-			Description:    m.Description,
-			AdminState:     edgexModels.Unlocked,
-			OperatingState: edgexModels.Up,
-			ProfileName:    m.Name,
-			ServiceName:    dDBC.args[ServiceName],
-			Protocols: map[string]edgexDtos.ProtocolProperties{
-				Canbus: {
-					NetType:  dDBC.args[NetType],
-					CommType: dDBC.args[CommType],
-					Network:  dDBC.args[Network],
-					Standard: J1939,
-					ID:       getOriginalCanId(m.ID),
-					DataSize: strconv.Itoa(int(m.Length)),
-					Sender:   m.SenderNode,
-				},
-			},
-			Tags: map[string]any{
-				PGN: getPGN(m.ID),
-			},
-		}
-		if dDBC.args[NetType] == NetTypeEthernet {
-			deviceDTO.Protocols[Canbus][Port] = dDBC.args[Port]
+	messages := dDBC.options.selectMessages(dDBC.compileResult.Database.Messages)
+	if !dDBC.options.GroupBySender {
+		for _, m := range messages {
+			deviceDTO := dDBC.newDevice(m.Name, m.Description, m.SenderNode)
+			properties := deviceDTO.Protocols[Canbus]
+			properties[ID] = getOriginalCanId(m.ID, m.IsExtended)
+			properties[DataSize] = strconv.Itoa(int(m.Length))
+			deviceDTO.AutoEvents = autoEvents(m, false)
+			if dDBC.compileResult.Extensions.IsFD(m) {
+				properties[FD] = strconv.FormatBool(true)
+			}
+			if m.IsExtended {
+				setJ1939Properties(properties, m.ID)
+				deviceDTO.Tags = map[string]any{
+					PGN: getPGN(m.ID),
+				}
+			}
+			dDBC.addDevice(deviceDTO, m)
 		}
+		return nil
+	}
 
-		validateErr := edgexCommon.Validate(deviceDTO)
-		if validateErr != nil {
-			dDBC.validateErrors[deviceDTO.Name] = validateErr
-		} else {
-			dDBC.devices = append(dDBC.devices, deviceDTO)
+	for _, group := range groupBySender(messages) {
+		sender := group[0].SenderNode
+		deviceDTO := dDBC.newDevice(sender, "", sender)
+		properties := deviceDTO.Protocols[Canbus]
+		isJ1939 := true
+		for _, m := range group {
+			deviceDTO.AutoEvents = append(deviceDTO.AutoEvents, autoEvents(m, true)...)
+			if dDBC.compileResult.Extensions.IsFD(m) {
+				properties[FD] = strconv.FormatBool(true)
+			}
+			isJ1939 = isJ1939 && m.IsExtended
+		}
+		if isJ1939 {
+			properties[Standard] = J1939
 		}
+		dDBC.addDevice(deviceDTO, group[0])
 	}
 	return nil
 }
 
+// newDevice returns the Device with the CANbus protocol properties of the conversion arguments
+func (dDBC *deviceDBC) newDevice(name, description, sender string) edgexDtos.Device {
+	deviceDTO := edgexDtos.Device{
+		Name:           name,
+		Description:    description,
+		AdminState:     edgexModels.Unlocked,
+		OperatingState: edgexModels.Up,
+		ProfileName:    name,
+		ServiceName:    dDBC.args[ServiceName],
+		Protocols: map[string]edgexDtos.ProtocolProperties{
+			Canbus: {
+				NetType:  dDBC.args[NetType],
+				CommType: dDBC.args[CommType],
+				Network:  dDBC.args[Network],
+				Standard: CAN,
+				Sender:   sender,
+			},
+		},
+	}
+	if dDBC.args[NetType] == NetTypeEthernet {
+		deviceDTO.Protocols[Canbus][Port] = dDBC.args[Port]
+	}
+	return deviceDTO
+}
+
+func (dDBC *deviceDBC) addDevice(deviceDTO edgexDtos.Device, m *descriptor.Message) {
+	validateErr := edgexCommon.Validate(deviceDTO)
+	var schedules []xrtmodels.Schedule
+	if validateErr == nil {
+		schedules, validateErr = xrtmodels.NewSchedulesFromAutoEvents(deviceDTO)
+	}
+	if validateErr != nil {
+		dDBC.validateErrors[deviceDTO.Name] = validateErr
+		dDBC.diagnostics = append(dDBC.diagnostics, invalidDTODiagnostic(dDBC.compileResult, m, validateErr))
+	} else {
+		dDBC.devices = append(dDBC.devices, deviceDTO)
+		dDBC.schedules[deviceDTO.Name] = schedules
+	}
+}
+
 func (dDBC *deviceDBC) GetDTOs() []edgexDtos.Device {
 	return dDBC.devices
 }
 
+// GetSchedulesByDeviceName returns the XRT Schedules of the converted Device converted from its AutoEvents
+func (dDBC *deviceDBC) GetSchedulesByDeviceName(name string) []xrtmodels.Schedule {
+	return dDBC.schedules[name]
+}
+
 func (dDBC *deviceDBC) GetValidateErrors() map[string]error {
 	return dDBC.validateErrors
 }
+
+func (dDBC *deviceDBC) GetDiagnostics() []Diagnostic {
+	return dDBC.diagnostics
+}
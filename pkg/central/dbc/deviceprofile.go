@@ -8,6 +8,8 @@ import (
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"go.einride.tech/can/pkg/descriptor"
 )
 
 // deviceProfileDBC stores the compiled DBC database and the converted DeviceProfile DTOs
@@ -15,86 +17,142 @@ type deviceProfileDBC struct {
 	compileResult  *CompileResult
 	deviceProfiles []edgexDtos.DeviceProfile
 	validateErrors map[string]error
+	diagnostics    []Diagnostic
+	options        ImportOptions
 }
 
-func newDeviceProfileDBC(data []byte) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
-	compileResult, err := Compile("", data)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to compile DBC file", err)
-	}
-
+func newDeviceProfileDBC(compileResult *CompileResult, options ImportOptions) Converter[[]edgexDtos.DeviceProfile] {
 	return &deviceProfileDBC{
 		compileResult:  compileResult,
 		deviceProfiles: make([]edgexDtos.DeviceProfile, 0),
 		validateErrors: make(map[string]error, len(compileResult.Database.Messages)),
-	}, nil
+		diagnostics:    append([]Diagnostic(nil), compileResult.Diagnostics...),
+		options:        options,
+	}
 }
 
 // ConvertToDTO parses the DBC messages and converts them to DeviceProfile DTOs
 func (dpDBC *deviceProfileDBC) ConvertToDTO() errors.EdgeX {
-	for _, m := range dpDBC.compileResult.Database.Messages {
-		var deviceResources []edgexDtos.DeviceResource
-		var deviceCommands []edgexDtos.DeviceCommand
+	messages := dpDBC.options.selectMessages(dpDBC.compileResult.Database.Messages)
+	if !dpDBC.options.GroupBySender {
+		for _, m := range messages {
+			var profileDto edgexDtos.DeviceProfile
+			profileDto.Name = m.Name
+			profileDto.Description = m.Description
+			profileDto.DeviceResources, profileDto.DeviceCommands = dpDBC.messageResources(m, false)
+			dpDBC.addProfile(profileDto, m)
+		}
+		return nil
+	}
+
+	for _, group := range groupBySender(messages) {
 		var profileDto edgexDtos.DeviceProfile
+		profileDto.Name = group[0].SenderNode
+		for _, m := range group {
+			deviceResources, deviceCommands := dpDBC.messageResources(m, true)
+			profileDto.DeviceResources = append(profileDto.DeviceResources, deviceResources...)
+			profileDto.DeviceCommands = append(profileDto.DeviceCommands, deviceCommands...)
+		}
+		dpDBC.addProfile(profileDto, group[0])
+	}
+	return nil
+}
 
-		for _, s := range m.Signals {
-			deviceResource := edgexDtos.DeviceResource{
-				Name:        s.Name,
-				Description: s.Description,
-				Properties: edgexDtos.ResourceProperties{
-					ValueType:    valueType(s),
-					ReadWrite:    edgexCommon.ReadWrite_R,
-					Units:        s.Unit,
-					Minimum:      &s.Min,
-					Maximum:      &s.Max,
-					Scale:        &s.Scale,
-					Offset:       &s.Offset,
-					DefaultValue: strconv.FormatInt(int64(s.DefaultValue), 10),
-				},
-				Attributes: map[string]interface{}{
-					BitStart:      s.Start,
-					BitLen:        s.Length,
-					LittleEndian:  !s.IsBigEndian,
-					ReceiverNames: s.ReceiverNodes,
-					MuxSignal:     s.IsMultiplexer,
-					IsSigned:      s.IsSigned,
-				},
-			}
-			if s.IsMultiplexed {
-				deviceResource.Attributes[MuxNum] = s.MultiplexerValue
+func (dpDBC *deviceProfileDBC) addProfile(profileDto edgexDtos.DeviceProfile, m *descriptor.Message) {
+	if validateErr := edgexCommon.Validate(profileDto); validateErr != nil {
+		dpDBC.validateErrors[profileDto.Name] = validateErr
+		dpDBC.diagnostics = append(dpDBC.diagnostics, invalidDTODiagnostic(dpDBC.compileResult, m, validateErr))
+	} else {
+		dpDBC.deviceProfiles = append(dpDBC.deviceProfiles, profileDto)
+	}
+}
+
+// messageResources converts the message signals to DeviceResources, and the signals with value descriptions to
+// DeviceCommands
+// The resources of the messages grouped by sender are named by the message and signal names, and have the CAN ID and
+// data size of the message, as well as the J1939 properties and PGN of the extended message since the Device of the
+// group can't define them for each message
+func (dpDBC *deviceProfileDBC) messageResources(m *descriptor.Message, grouped bool) ([]edgexDtos.DeviceResource, []edgexDtos.DeviceCommand) {
+	extensions := dpDBC.compileResult.Extensions
+	var deviceResources []edgexDtos.DeviceResource
+	var deviceCommands []edgexDtos.DeviceCommand
+	for _, s := range m.Signals {
+		deviceResource := edgexDtos.DeviceResource{
+			Name:        resourceName(m, s.Name, grouped),
+			Description: s.Description,
+			Properties: edgexDtos.ResourceProperties{
+				ValueType:    valueType(s),
+				ReadWrite:    dpDBC.options.readWrite(s),
+				Units:        s.Unit,
+				Minimum:      &s.Min,
+				Maximum:      &s.Max,
+				Scale:        &s.Scale,
+				Offset:       &s.Offset,
+				DefaultValue: strconv.FormatInt(int64(s.DefaultValue), 10),
+			},
+			Attributes: map[string]interface{}{
+				BitStart:      s.Start,
+				BitLen:        s.Length,
+				LittleEndian:  !s.IsBigEndian,
+				ReceiverNames: s.ReceiverNodes,
+				MuxSignal:     s.IsMultiplexer,
+				IsSigned:      s.IsSigned,
+			},
+		}
+		if grouped {
+			deviceResource.Attributes[ID] = getOriginalCanId(m.ID, m.IsExtended)
+			deviceResource.Attributes[DataSize] = m.Length
+			if m.IsExtended {
+				setJ1939Properties(deviceResource.Attributes, m.ID)
+				deviceResource.Attributes[PGN] = getPGN(m.ID)
 			}
-			if len(s.ValueDescriptions) > 0 {
-				var deviceCommand edgexDtos.DeviceCommand
-				deviceCommand.Name = s.Name
-				deviceCommand.ReadWrite = edgexCommon.ReadWrite_R
-				mappings := make(map[string]string, len(s.ValueDescriptions))
-				for _, valueDescription := range s.ValueDescriptions {
-					mappings[strconv.FormatInt(valueDescription.Value, 10)] = valueDescription.Description
-				}
-				deviceCommand.ResourceOperations = []edgexDtos.ResourceOperation{
-					{
-						DeviceResource: s.Name,
-						DefaultValue:   strconv.FormatInt(int64(s.DefaultValue), 10),
-						Mappings:       mappings,
-					},
-				}
-				deviceCommands = append(deviceCommands, deviceCommand)
+		}
+		if s.IsMultiplexed {
+			deviceResource.Attributes[MuxNum] = s.MultiplexerValue
+		}
+		if start := extensions.StartBit(s); start > uint16(s.Start) {
+			deviceResource.Attributes[BitStart] = start
+		}
+		if s.IsFloat {
+			deviceResource.Attributes[IsFloat] = true
+		}
+		if muxSwitch, muxRanges := extensions.Multiplexing(s); len(muxRanges) > 0 {
+			ranges := make([]string, len(muxRanges))
+			for i, muxRange := range muxRanges {
+				ranges[i] = muxRange.String()
 			}
-			deviceResources = append(deviceResources, deviceResource)
+			deviceResource.Attributes[MuxSwitch] = resourceName(m, muxSwitch, grouped)
+			deviceResource.Attributes[MuxRanges] = ranges
 		}
-
-		profileDto.Name = m.Name
-		profileDto.Description = m.Description
-		profileDto.DeviceResources = deviceResources
-		profileDto.DeviceCommands = deviceCommands
-
-		if validateErr := edgexCommon.Validate(profileDto); validateErr != nil {
-			dpDBC.validateErrors[profileDto.Name] = validateErr
-		} else {
-			dpDBC.deviceProfiles = append(dpDBC.deviceProfiles, profileDto)
+		if isMultiPacket(m, extensions.IsFD(m)) {
+			deviceResource.Attributes[MultiPacket] = true
 		}
+		if len(s.ValueDescriptions) > 0 {
+			var deviceCommand edgexDtos.DeviceCommand
+			deviceCommand.Name = deviceResource.Name
+			deviceCommand.ReadWrite = deviceResource.Properties.ReadWrite
+			mappings := make(map[string]string, len(s.ValueDescriptions))
+			labelValues := make(map[string]string, len(s.ValueDescriptions))
+			for _, valueDescription := range s.ValueDescriptions {
+				mappings[strconv.FormatInt(valueDescription.Value, 10)] = valueDescription.Description
+				labelValues[valueDescription.Description] = strconv.FormatInt(valueDescription.Value, 10)
+			}
+			// the text labels written to the writable resource are translated to the raw values by the reverse mappings
+			if deviceResource.Properties.ReadWrite == edgexCommon.ReadWrite_RW {
+				deviceResource.Attributes[LabelValues] = labelValues
+			}
+			deviceCommand.ResourceOperations = []edgexDtos.ResourceOperation{
+				{
+					DeviceResource: deviceResource.Name,
+					DefaultValue:   strconv.FormatInt(int64(s.DefaultValue), 10),
+					Mappings:       mappings,
+				},
+			}
+			deviceCommands = append(deviceCommands, deviceCommand)
+		}
+		deviceResources = append(deviceResources, deviceResource)
 	}
-	return nil
+	return deviceResources, deviceCommands
 }
 
 func (dpDBC *deviceProfileDBC) GetDTOs() []edgexDtos.DeviceProfile {
@@ -104,3 +162,7 @@ func (dpDBC *deviceProfileDBC) GetDTOs() []edgexDtos.DeviceProfile {
 func (dpDBC *deviceProfileDBC) GetValidateErrors() map[string]error {
 	return dpDBC.validateErrors
 }
+
+func (dpDBC *deviceProfileDBC) GetDiagnostics() []Diagnostic {
+	return dpDBC.diagnostics
+}
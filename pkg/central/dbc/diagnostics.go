@@ -0,0 +1,154 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"errors"
+	"fmt"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// DiagnosticSeverity is the severity of a DBC diagnostic
+type DiagnosticSeverity string
+
+const (
+	// SeverityError means the message or signal can't be used as defined, e.g. the signal exceeds the message length
+	SeverityError DiagnosticSeverity = "error"
+	// SeverityWarning means the definition is skipped or may be unintended, e.g. the comment of an undeclared signal
+	SeverityWarning DiagnosticSeverity = "warning"
+)
+
+// DiagnosticCode is the kind of a DBC diagnostic
+type DiagnosticCode string
+
+const (
+	DiagnosticInvalidDefinition    DiagnosticCode = "InvalidDefinition"
+	DiagnosticMissingMessage       DiagnosticCode = "MissingMessage"
+	DiagnosticMissingSignal        DiagnosticCode = "MissingSignal"
+	DiagnosticMissingNode          DiagnosticCode = "MissingNode"
+	DiagnosticMissingAttribute     DiagnosticCode = "MissingAttribute"
+	DiagnosticOverlappingSignals   DiagnosticCode = "OverlappingSignals"
+	DiagnosticSignalExceedsMessage DiagnosticCode = "SignalExceedsMessage"
+	DiagnosticInvalidDTO           DiagnosticCode = "InvalidDTO"
+)
+
+// Diagnostic describes why a DBC definition is skipped or may not convert as expected
+type Diagnostic struct {
+	// Line is the 1-based line of the definition in the DBC file, 0 if unknown
+	Line     int                `json:"line,omitempty"`
+	Severity DiagnosticSeverity `json:"severity"`
+	Code     DiagnosticCode     `json:"code"`
+	// MessageName and SignalName are the names of the affected message and signal if known
+	MessageName string `json:"messageName,omitempty"`
+	SignalName  string `json:"signalName,omitempty"`
+	Reason      string `json:"reason"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("line %d: %s: %s", d.Line, d.Severity, d.Reason)
+}
+
+// addDiagnostic adds the warning of the definition which has no DBC definition, e.g. of the KCD and ARXML files
+func (c *compiler) addDiagnostic(code DiagnosticCode, messageName, reason string) {
+	c.warnings = append(c.warnings, errors.New(reason))
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Severity:    SeverityWarning,
+		Code:        code,
+		MessageName: messageName,
+		Reason:      reason,
+	})
+}
+
+// checkDescriptors checks the nodes and the signal layouts of the compiled messages
+func (c *compiler) checkDescriptors() {
+	nodes := make(map[string]bool, len(c.db.Nodes))
+	for _, node := range c.db.Nodes {
+		nodes[node.Name] = true
+	}
+	for _, m := range c.db.Messages {
+		if m.SenderNode != "" && m.SenderNode != defaultNode && !nodes[m.SenderNode] {
+			c.diagnostics = append(c.diagnostics, Diagnostic{
+				Line:        c.messageLines[m],
+				Severity:    SeverityWarning,
+				Code:        DiagnosticMissingNode,
+				MessageName: m.Name,
+				Reason:      fmt.Sprintf("sender node '%s' is not declared", m.SenderNode),
+			})
+		}
+
+		bits := make(map[*descriptor.Signal][]int, len(m.Signals))
+		for _, s := range m.Signals {
+			for _, receiver := range s.ReceiverNodes {
+				if receiver != defaultNode && !nodes[receiver] {
+					c.addSignalDiagnostic(m, s, SeverityWarning, DiagnosticMissingNode,
+						fmt.Sprintf("receiver node '%s' is not declared", receiver))
+				}
+			}
+			start := c.extensions.StartBit(s)
+			if _, err := signalBits(s, start, int(m.Length)); err != nil {
+				c.addSignalDiagnostic(m, s, SeverityError, DiagnosticSignalExceedsMessage,
+					fmt.Sprintf("signal bits %d|%d exceed the message length %d", start, s.Length, m.Length))
+			}
+			if positions, err := signalBits(s, start, MaxDataSize); err == nil {
+				bits[s] = positions
+			}
+		}
+
+		for i, s := range m.Signals {
+			for _, other := range m.Signals[i+1:] {
+				if c.coexist(s, other) && overlap(bits[s], bits[other]) {
+					c.addSignalDiagnostic(m, other, SeverityWarning, DiagnosticOverlappingSignals,
+						fmt.Sprintf("signal bits overlap with signal '%s'", s.Name))
+				}
+			}
+		}
+	}
+}
+
+func (c *compiler) addSignalDiagnostic(m *descriptor.Message, s *descriptor.Signal, severity DiagnosticSeverity, code DiagnosticCode, reason string) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Line:        c.signalLines[s],
+		Severity:    severity,
+		Code:        code,
+		MessageName: m.Name,
+		SignalName:  s.Name,
+		Reason:      reason,
+	})
+}
+
+// coexist returns whether both signals can be in the same frame, the signals of the extended multiplexing are
+// not checked
+func (c *compiler) coexist(s, other *descriptor.Signal) bool {
+	if _, ranges := c.extensions.Multiplexing(s); len(ranges) > 0 {
+		return false
+	}
+	if _, ranges := c.extensions.Multiplexing(other); len(ranges) > 0 {
+		return false
+	}
+	return !s.IsMultiplexed || !other.IsMultiplexed || s.MultiplexerValue == other.MultiplexerValue
+}
+
+func overlap(positions, others []int) bool {
+	bits := make(map[int]bool, len(positions))
+	for _, position := range positions {
+		bits[position] = true
+	}
+	for _, position := range others {
+		if bits[position] {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidDTODiagnostic returns the diagnostic of the message whose converted DTO fails the validation
+func invalidDTODiagnostic(result *CompileResult, m *descriptor.Message, err error) Diagnostic {
+	return Diagnostic{
+		Line:        result.messageLines[m],
+		Severity:    SeverityError,
+		Code:        DiagnosticInvalidDTO,
+		MessageName: m.Name,
+		Reason:      err.Error(),
+	}
+}
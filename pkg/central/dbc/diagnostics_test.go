@@ -0,0 +1,78 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDiagnosticsDBC = `VERSION ""
+
+BU_: ECU
+
+BO_ 1 Engine: 8 Gateway
+ SG_ Speed : 0|16@1+ (1,0) [0|0] "" Dashboard
+ SG_ Torque : 8|8@1+ (1,0) [0|0] "" ECU
+ SG_ Temperature : 60|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 2 Status: 8 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Counter m1 : 8|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Level m2 : 8|8@1+ (1,0) [0|0] "" Vector__XXX
+
+CM_ SG_ 1 Unknown "Unknown signal";
+CM_ BO_ 3 "Unknown message";
+`
+
+func TestCompile_Diagnostics(t *testing.T) {
+	result, err := Compile("", []byte(testDiagnosticsDBC))
+	require.NoError(t, err)
+
+	expected := []Diagnostic{
+		{Line: 15, Severity: SeverityWarning, Code: DiagnosticMissingSignal, Reason: "no declared signal"},
+		{Line: 16, Severity: SeverityWarning, Code: DiagnosticMissingMessage, Reason: "no declared message"},
+		{Line: 5, Severity: SeverityWarning, Code: DiagnosticMissingNode, MessageName: "Engine", Reason: "sender node 'Gateway' is not declared"},
+		{Line: 6, Severity: SeverityWarning, Code: DiagnosticMissingNode, MessageName: "Engine", SignalName: "Speed", Reason: "receiver node 'Dashboard' is not declared"},
+		{Line: 8, Severity: SeverityError, Code: DiagnosticSignalExceedsMessage, MessageName: "Engine", SignalName: "Temperature", Reason: "signal bits 60|8 exceed the message length 8"},
+		{Line: 7, Severity: SeverityWarning, Code: DiagnosticOverlappingSignals, MessageName: "Engine", SignalName: "Torque", Reason: "signal bits overlap with signal 'Speed'"},
+	}
+	assert.Equal(t, expected, result.Diagnostics)
+	assert.Len(t, result.Warnings, 2)
+	assert.Equal(t, "line 15: warning: no declared signal", result.Diagnostics[0].String())
+}
+
+func TestConverter_GetDiagnostics(t *testing.T) {
+	data := []byte(testDiagnosticsDBC)
+	profileConverter, err := ConvertDBCtoProfile(data)
+	require.NoError(t, err)
+	require.Len(t, profileConverter.GetDiagnostics(), 6)
+
+	deviceConverter, err := ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can"})
+	require.NoError(t, err)
+	require.Equal(t, profileConverter.GetDiagnostics(), deviceConverter.GetDiagnostics())
+
+	result, compileErr := Compile("", data)
+	require.NoError(t, compileErr)
+	message := result.Database.Messages[0]
+	assert.Equal(t, Diagnostic{Line: 5, Severity: SeverityError, Code: DiagnosticInvalidDTO, MessageName: "Engine", Reason: "invalid"},
+		invalidDTODiagnostic(result, message, fmt.Errorf("invalid")))
+}
+
+func TestCompile_AttributeOfUndeclaredSignal(t *testing.T) {
+	result, err := Compile("", []byte(`VERSION ""
+
+BO_ 1 Message: 8 ECU
+ SG_ Signal : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BA_DEF_ SG_  "GenSigStartValue" INT 0 100;
+BA_ "GenSigStartValue" SG_ 1 Unknown 1;
+`))
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 1)
+	assert.Equal(t, DiagnosticMissingSignal, result.Diagnostics[0].Code)
+	assert.Equal(t, 7, result.Diagnostics[0].Line)
+}
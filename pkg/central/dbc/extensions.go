@@ -0,0 +1,150 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/can/pkg/dbc"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const (
+	attributeVFrameFormat = "VFrameFormat"
+
+	keywordSignalMultiplexValue = "SG_MUL_VAL_"
+)
+
+// frameFormats are the VFrameFormat enum values, the CAN FD formats have the _FD suffix
+var frameFormats = []string{
+	"StandardCAN", "ExtendedCAN", "reserved", "reserved", "reserved", "reserved", "reserved", "reserved", "reserved",
+	"reserved", "reserved", "reserved", "reserved", "reserved", "StandardCAN_FD", "ExtendedCAN_FD",
+}
+
+var (
+	// extendedMultiplexerPattern matches the signals which are both multiplexed and multiplexer, e.g. SG_ Mode m1M : ...
+	// The DBC parser doesn't support this multiplexer indicator
+	extendedMultiplexerPattern  = regexp.MustCompile(`^(\s*SG_\s+\w+\s+m\d+)M(\s*:)`)
+	signalMultiplexValuePattern = regexp.MustCompile(`^\s*SG_MUL_VAL_\s+(\d+)\s+(\w+)\s+(\w+)\s+(.+?)\s*;\s*$`)
+	multiplexRangePattern       = regexp.MustCompile(`^(\d+)\s*-\s*(\d+)$`)
+)
+
+// Extensions has the DBC definitions of the messages and signals which the descriptor types can't describe
+type Extensions struct {
+	Messages map[*descriptor.Message]*MessageExtension
+	Signals  map[*descriptor.Signal]*SignalExtension
+}
+
+// MessageExtension has the CAN FD frame format of the message
+type MessageExtension struct {
+	// FrameFormat is the VFrameFormat attribute value, e.g. StandardCAN_FD
+	FrameFormat string
+}
+
+// SignalExtension has the start bit of the CAN FD signals, the IEEE float value type and the extended multiplexing of the signal
+type SignalExtension struct {
+	// StartBit is the start bit of the signal, which exceeds the uint8 descriptor start bit in CAN FD messages
+	StartBit uint16
+	// ValueType is the SIG_VALTYPE_ value type of the signal
+	ValueType dbc.SignalValueType
+	// MultiplexerSwitch is the name of the multiplexer signal of the extended multiplexing
+	MultiplexerSwitch string
+	// MultiplexerRanges are the multiplexer values selecting the signal by the extended multiplexing
+	MultiplexerRanges []MultiplexerRange
+}
+
+// MultiplexerRange is an inclusive range of multiplexer values
+type MultiplexerRange struct {
+	From uint64
+	To   uint64
+}
+
+func (r MultiplexerRange) String() string {
+	return fmt.Sprintf("%d-%d", r.From, r.To)
+}
+
+// parseMultiplexerRange parses the multiplexer range in the SG_MUL_VAL_ format, e.g. 1-3
+func parseMultiplexerRange(s string) (MultiplexerRange, error) {
+	matches := multiplexRangePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return MultiplexerRange{}, fmt.Errorf("invalid multiplexer range '%s'", s)
+	}
+	from, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return MultiplexerRange{}, fmt.Errorf("invalid multiplexer range '%s': %w", s, err)
+	}
+	to, err := strconv.ParseUint(matches[2], 10, 64)
+	if err != nil {
+		return MultiplexerRange{}, fmt.Errorf("invalid multiplexer range '%s': %w", s, err)
+	}
+	if from > to {
+		return MultiplexerRange{}, fmt.Errorf("invalid multiplexer range '%s'", s)
+	}
+	return MultiplexerRange{From: from, To: to}, nil
+}
+
+func newExtensions() *Extensions {
+	return &Extensions{
+		Messages: make(map[*descriptor.Message]*MessageExtension),
+		Signals:  make(map[*descriptor.Signal]*SignalExtension),
+	}
+}
+
+func (e *Extensions) message(m *descriptor.Message) *MessageExtension {
+	if _, ok := e.Messages[m]; !ok {
+		e.Messages[m] = &MessageExtension{}
+	}
+	return e.Messages[m]
+}
+
+func (e *Extensions) signal(s *descriptor.Signal) *SignalExtension {
+	if _, ok := e.Signals[s]; !ok {
+		e.Signals[s] = &SignalExtension{StartBit: uint16(s.Start)}
+	}
+	return e.Signals[s]
+}
+
+// IsFD returns whether the message is a CAN FD message by the VFrameFormat attribute
+func (e *Extensions) IsFD(m *descriptor.Message) bool {
+	if e == nil || e.Messages[m] == nil {
+		return false
+	}
+	return strings.HasSuffix(e.Messages[m].FrameFormat, "_FD")
+}
+
+// StartBit returns the start bit of the signal, including the start bits exceeding 255 in CAN FD messages
+func (e *Extensions) StartBit(s *descriptor.Signal) uint16 {
+	if e == nil || e.Signals[s] == nil {
+		return uint16(s.Start)
+	}
+	return e.Signals[s].StartBit
+}
+
+// Multiplexing returns the multiplexer signal name and the multiplexer value ranges of the extended multiplexing
+// of the signal, the ranges are empty if the signal uses the simple multiplexing
+func (e *Extensions) Multiplexing(s *descriptor.Signal) (string, []MultiplexerRange) {
+	if e == nil || e.Signals[s] == nil {
+		return "", nil
+	}
+	return e.Signals[s].MultiplexerSwitch, e.Signals[s].MultiplexerRanges
+}
+
+// preprocess replaces the extended multiplexer indicators which the DBC parser doesn't support, and returns the lines
+// of the replaced indicators, whose signals are multiplexer signals as well
+func preprocess(data []byte) ([]byte, map[int]bool) {
+	lines := strings.Split(string(data), "\n")
+	multiplexerLines := make(map[int]bool)
+	for i, line := range lines {
+		if extendedMultiplexerPattern.MatchString(line) {
+			lines[i] = extendedMultiplexerPattern.ReplaceAllString(line, "$1 $2")
+			multiplexerLines[i+1] = true
+		}
+	}
+	if len(multiplexerLines) == 0 {
+		return data, multiplexerLines
+	}
+	return []byte(strings.Join(lines, "\n")), multiplexerLines
+}
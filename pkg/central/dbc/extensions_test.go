@@ -0,0 +1,183 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.einride.tech/can/pkg/dbc"
+)
+
+const testExtensionsDBC = `VERSION ""
+
+BU_: ECU
+
+BO_ 2147484000 Measurement: 64 ECU
+ SG_ Counter : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Pressure : 400|32@1- (1,0) [0|0] "bar" Vector__XXX
+ SG_ Energy : 448|64@1- (0.5,0) [0|0] "kWh" Vector__XXX
+
+BO_ 512 Diagnostics: 8 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Service m1M : 8|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Code m2 : 16|16@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Status m2 : 8|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BA_DEF_ BO_  "VFrameFormat" ENUM  "StandardCAN","ExtendedCAN","reserved","reserved","reserved","reserved","reserved","reserved","reserved","reserved","reserved","reserved","reserved","reserved","StandardCAN_FD","ExtendedCAN_FD";
+BA_DEF_ BO_  "GenMsgCycleTime" INT 0 65535;
+BA_DEF_ SG_  "GenSigStartValue" FLOAT -1000 1000;
+BA_DEF_DEF_  "VFrameFormat" "StandardCAN";
+BA_DEF_DEF_  "GenMsgCycleTime" 100;
+BA_DEF_DEF_  "GenSigStartValue" 7;
+BA_ "VFrameFormat" BO_ 2147484000 15;
+BA_ "GenMsgCycleTime" BO_ 512 20;
+BA_ "GenSigStartValue" SG_ 512 Mode 1.5;
+
+SIG_VALTYPE_ 2147484000 Pressure : 1;
+SIG_VALTYPE_ 2147484000 Energy : 2;
+SG_MUL_VAL_ 512 Service Mode 1-1;
+SG_MUL_VAL_ 512 Code Service 2-3, 5-5;
+`
+
+func TestCompile_Extensions(t *testing.T) {
+	result, err := Compile("", []byte(testExtensionsDBC))
+	require.NoError(t, err)
+	require.Empty(t, result.Warnings)
+	require.Len(t, result.Database.Messages, 2)
+	measurement, diagnostics := result.Database.Messages[0], result.Database.Messages[1]
+	extensions := result.Extensions
+
+	// BA_DEF_DEF_ defaults and BA_ values
+	assert.Equal(t, 100*time.Millisecond, measurement.CycleTime)
+	assert.Equal(t, 20*time.Millisecond, diagnostics.CycleTime)
+	mode, ok := result.Database.Signal(diagnostics.ID, "Mode")
+	require.True(t, ok)
+	assert.Equal(t, 1, mode.DefaultValue)
+	assert.Equal(t, 7, measurement.Signals[0].DefaultValue)
+
+	// CAN FD
+	assert.True(t, extensions.IsFD(measurement))
+	assert.False(t, extensions.IsFD(diagnostics))
+	pressure, ok := result.Database.Signal(measurement.ID, "Pressure")
+	require.True(t, ok)
+	assert.Equal(t, uint16(400), extensions.StartBit(pressure))
+	assert.True(t, pressure.IsFloat)
+	assert.Equal(t, dbc.SignalValueTypeFloat32, extensions.Signals[pressure].ValueType)
+	energy, ok := result.Database.Signal(measurement.ID, "Energy")
+	require.True(t, ok)
+	assert.Equal(t, uint16(448), extensions.StartBit(energy))
+	assert.Equal(t, []string{"Counter", "Pressure", "Energy"}, []string{measurement.Signals[0].Name, measurement.Signals[1].Name, measurement.Signals[2].Name})
+
+	// extended multiplexing
+	service, ok := result.Database.Signal(diagnostics.ID, "Service")
+	require.True(t, ok)
+	assert.True(t, service.IsMultiplexer)
+	assert.True(t, service.IsMultiplexed)
+	code, ok := result.Database.Signal(diagnostics.ID, "Code")
+	require.True(t, ok)
+	muxSwitch, muxRanges := extensions.Multiplexing(code)
+	assert.Equal(t, "Service", muxSwitch)
+	assert.Equal(t, []MultiplexerRange{{From: 2, To: 3}, {From: 5, To: 5}}, muxRanges)
+	status, ok := result.Database.Signal(diagnostics.ID, "Status")
+	require.True(t, ok)
+	muxSwitch, muxRanges = extensions.Multiplexing(status)
+	assert.Empty(t, muxSwitch)
+	assert.Empty(t, muxRanges)
+}
+
+func TestCompile_ExtensionWarnings(t *testing.T) {
+	result, err := Compile("", []byte(`VERSION ""
+
+BO_ 512 Diagnostics: 8 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Code m1 : 8|16@1+ (1,0) [0|0] "" Vector__XXX
+
+SIG_VALTYPE_ 512 Code : 1;
+SIG_VALTYPE_ 512 Unknown : 1;
+SG_MUL_VAL_ 512 Code Unknown 1-1;
+SG_MUL_VAL_ 512 Code Mode 3-1;
+SG_MUL_VAL_ 512 Code;
+`))
+	require.NoError(t, err)
+	assert.Len(t, result.Warnings, 5)
+}
+
+func TestConvertDBC_Extensions(t *testing.T) {
+	data := []byte(testExtensionsDBC)
+	profiles, devices := convertDBC(t, data)
+	require.Len(t, profiles, 2)
+
+	measurement := profiles[0]
+	require.Equal(t, "Measurement", measurement.Name)
+	assert.Equal(t, edgexCommon.ValueTypeUint64, measurement.DeviceResources[0].Properties.ValueType)
+	pressure := measurement.DeviceResources[1]
+	assert.Equal(t, edgexCommon.ValueTypeFloat32, pressure.Properties.ValueType)
+	assert.Equal(t, uint16(400), pressure.Attributes[BitStart])
+	assert.Equal(t, true, pressure.Attributes[IsFloat])
+	assert.NotContains(t, pressure.Attributes, MultiPacket)
+	assert.Equal(t, edgexCommon.ValueTypeFloat64, measurement.DeviceResources[2].Properties.ValueType)
+
+	var code edgexDtos.DeviceResource
+	for _, resource := range profiles[1].DeviceResources {
+		if resource.Name == "Code" {
+			code = resource
+		}
+	}
+	assert.Equal(t, "Service", code.Attributes[MuxSwitch])
+	assert.Equal(t, []string{"2-3", "5-5"}, code.Attributes[MuxRanges])
+
+	assert.Equal(t, "true", devices[0].Protocols[Canbus][FD])
+	assert.NotContains(t, devices[1].Protocols[Canbus], FD)
+
+	result, validateErrors, err := ConvertProfilesToDBC(profiles, devices)
+	require.NoError(t, err)
+	require.Empty(t, validateErrors)
+	resultProfiles, resultDevices := convertDBC(t, result)
+	assert.Equal(t, profiles, resultProfiles)
+	assert.Equal(t, devices, resultDevices)
+}
+
+func TestFrameCodec_Extensions(t *testing.T) {
+	codec, err := NewFrameCodecFromDBC([]byte(testExtensionsDBC))
+	require.NoError(t, err)
+
+	data := make([]byte, 64)
+	data[0] = 9
+	binary.LittleEndian.PutUint32(data[50:], math.Float32bits(2.5))
+	binary.LittleEndian.PutUint64(data[56:], math.Float64bits(-10))
+	values := readingValues(t, codec, 2147484000, data)
+	assert.Equal(t, map[string]string{"Counter": "9", "Pressure": "2.5e+00", "Energy": "-5e+00"}, values)
+
+	encoded, err := codec.Encode(2147484000, map[string]any{"Counter": 9, "Pressure": float32(2.5), "Energy": -5})
+	require.NoError(t, err)
+	assert.Equal(t, data, encoded)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected map[string]string
+	}{
+		{"service not selected", []byte{2, 3, 0x34, 0x12, 0, 0, 0, 0}, map[string]string{"Mode": "2", "Status": "3"}},
+		{"code not selected", []byte{1, 4, 0x34, 0x12, 0, 0, 0, 0}, map[string]string{"Mode": "1", "Service": "4"}},
+		{"code selected", []byte{1, 5, 0x34, 0x12, 0, 0, 0, 0}, map[string]string{"Mode": "1", "Service": "5", "Code": "4660"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, readingValues(t, codec, 512, tt.data))
+		})
+	}
+
+	encoded, err = codec.Encode(512, map[string]any{"Service": 3, "Code": 4660})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 3, 0x34, 0x12, 0, 0, 0, 0}, encoded)
+	_, err = codec.Encode(512, map[string]any{"Service": 4, "Code": 4660})
+	require.Error(t, err)
+}
@@ -0,0 +1,373 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"fmt"
+	"math"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/spf13/cast"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const (
+	// MaxDataSize is the maximum data size of CAN FD frames, the classic CAN frames have up to 8 bytes
+	MaxDataSize = 64
+)
+
+// FrameCodec decodes the CAN frames to readings and encodes the resource values to CAN frames by the DBC messages
+// The message of a frame is found by the CAN ID, which is the ID protocol property of the Device converted from the DBC
+type FrameCodec struct {
+	db         *descriptor.Database
+	extensions *Extensions
+}
+
+// NewFrameCodec returns the FrameCodec of the compiled DBC database
+// The extensions of the compile result are optional, which are required by the CAN FD signals starting after bit 255
+// and the extended multiplexing
+func NewFrameCodec(db *descriptor.Database, extensions *Extensions) *FrameCodec {
+	return &FrameCodec{db: db, extensions: extensions}
+}
+
+// NewFrameCodecFromDBC compiles the DBC file content and returns the FrameCodec of the DBC database
+func NewFrameCodecFromDBC(data []byte) (*FrameCodec, errors.EdgeX) {
+	compileResult, err := Compile("", data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to compile DBC file", err)
+	}
+	return NewFrameCodec(compileResult.Database, compileResult.Extensions), nil
+}
+
+// message returns the DBC message of the CAN ID, which has the most significant bit set for extended CAN IDs
+func (c *FrameCodec) message(canID uint32) (*descriptor.Message, errors.EdgeX) {
+	message, ok := c.db.Message(canID &^ messageIDExtendedFlag)
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no DBC message with CAN ID %d", canID), nil)
+	}
+	return message, nil
+}
+
+// Decode decodes the CAN frame data to the readings of the signals, the profile name of the readings is the message name
+// Only the multiplexed signals selected by the multiplexer signal value are decoded
+func (c *FrameCodec) Decode(deviceName string, canID uint32, data []byte) ([]edgexDtos.BaseReading, errors.EdgeX) {
+	message, edgexErr := c.message(canID)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+	if len(data) > MaxDataSize {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("CAN frame data size %d exceeds %d bytes", len(data), MaxDataSize), nil)
+	}
+
+	readings := make([]edgexDtos.BaseReading, 0, len(message.Signals))
+	for _, s := range message.Signals {
+		selected, err := c.selected(message, s, func(multiplexer *descriptor.Signal) (uint64, error) {
+			return c.readSignal(multiplexer, data)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the multiplexer of signal '%s'", s.Name), err)
+		}
+		if !selected {
+			continue
+		}
+		raw, err := c.readSignal(s, data)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode signal '%s'", s.Name), err)
+		}
+		reading, err := edgexDtos.NewSimpleReading(message.Name, deviceName, s.Name, valueType(s), physicalValue(s, raw))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create the reading of signal '%s'", s.Name), err)
+		}
+		readings = append(readings, reading)
+	}
+	return readings, nil
+}
+
+// Encode encodes the resource values to the CAN frame data of the message length
+// The signals without value are encoded with the default values, and the multiplexed signals must match the
+// multiplexer signal value
+func (c *FrameCodec) Encode(canID uint32, values map[string]any) ([]byte, errors.EdgeX) {
+	message, edgexErr := c.message(canID)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+	for name := range values {
+		if !hasSignal(message, name) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no signal '%s' in DBC message '%s'", name, message.Name), nil)
+		}
+	}
+
+	data := make([]byte, message.Length)
+	for _, s := range message.Signals {
+		selected, err := c.selected(message, s, func(multiplexer *descriptor.Signal) (uint64, error) {
+			return rawSignalValue(multiplexer, values)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the multiplexer of signal '%s'", s.Name), err)
+		}
+		if !selected {
+			if _, ok := values[s.Name]; ok {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("signal '%s' is not selected by the multiplexer value", s.Name), nil)
+			}
+			continue
+		}
+		raw, err := rawSignalValue(s, values)
+		if err == nil {
+			err = c.writeSignal(s, data, raw)
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode signal '%s'", s.Name), err)
+		}
+	}
+	return data, nil
+}
+
+func hasSignal(message *descriptor.Message, name string) bool {
+	_, ok := messageSignal(message, name)
+	return ok
+}
+
+func messageSignal(message *descriptor.Message, name string) (*descriptor.Signal, bool) {
+	for _, s := range message.Signals {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// selected returns whether the signal is selected by the value of its multiplexer signal, which is selected by its own
+// multiplexer signal for the extended multiplexing
+func (c *FrameCodec) selected(message *descriptor.Message, s *descriptor.Signal, muxValue func(*descriptor.Signal) (uint64, error)) (bool, error) {
+	// the multiplexer signals are nested by the extended multiplexing, which must not have cycles
+	for depth := 0; s.IsMultiplexed; depth++ {
+		if depth >= len(message.Signals) {
+			return false, fmt.Errorf("cyclic multiplexing of signal '%s'", s.Name)
+		}
+		muxSwitch, muxRanges := c.extensions.Multiplexing(s)
+		var multiplexer *descriptor.Signal
+		var ok bool
+		if muxSwitch != "" {
+			multiplexer, ok = messageSignal(message, muxSwitch)
+		} else {
+			multiplexer, ok = message.MultiplexerSignal()
+		}
+		if !ok {
+			return false, fmt.Errorf("no multiplexer signal")
+		}
+		value, err := muxValue(multiplexer)
+		if err != nil {
+			return false, err
+		}
+		if len(muxRanges) == 0 {
+			muxRanges = []MultiplexerRange{{From: uint64(s.MultiplexerValue), To: uint64(s.MultiplexerValue)}}
+		}
+		inRange := false
+		for _, muxRange := range muxRanges {
+			inRange = inRange || (value >= muxRange.From && value <= muxRange.To)
+		}
+		if !inRange {
+			return false, nil
+		}
+		s = multiplexer
+	}
+	return true, nil
+}
+
+// physicalValue converts the raw signal bits to the physical value of the signal value type
+func physicalValue(s *descriptor.Signal, raw uint64) any {
+	if s.IsFloat {
+		if valueType(s) == edgexCommon.ValueTypeFloat32 {
+			return math.Float32frombits(uint32(raw)) // #nosec G115
+		}
+		return floatValue(s, raw)*s.Scale + s.Offset
+	}
+	// the scale and offset of the integer value types are integers
+	switch valueType(s) {
+	case edgexCommon.ValueTypeInt64:
+		if !s.IsSigned {
+			return int64(raw)*int64(s.Scale) + int64(s.Offset) // #nosec G115
+		}
+		return signExtend(raw, s.Length)*int64(s.Scale) + int64(s.Offset)
+	case edgexCommon.ValueTypeUint64:
+		return raw*uint64(s.Scale) + uint64(s.Offset)
+	default:
+		value := float64(raw)
+		if s.IsSigned {
+			value = float64(signExtend(raw, s.Length))
+		}
+		return value*s.Scale + s.Offset
+	}
+}
+
+// rawSignalValue returns the raw signal bits of the physical resource value, or of the signal default value if the
+// resource has no value
+func rawSignalValue(s *descriptor.Signal, values map[string]any) (uint64, error) {
+	value, ok := values[s.Name]
+	if !ok {
+		return uint64(int64(s.DefaultValue)) & bitMask(s.Length), nil
+	}
+	if label, ok := value.(string); ok {
+		if raw, ok := labelValue(s, label); ok {
+			if s.IsSigned {
+				return rawSigned(s, raw)
+			}
+			if raw < 0 {
+				return 0, fmt.Errorf("negative value %d of label '%s' of unsigned signal", raw, label)
+			}
+			return rawUnsigned(s, uint64(raw))
+		}
+	}
+	physical, err := cast.ToFloat64E(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%v': %w", value, err)
+	}
+	if s.Min < s.Max && (physical < s.Min || physical > s.Max) {
+		return 0, fmt.Errorf("value %v out of range [%v, %v]", value, s.Min, s.Max)
+	}
+	if s.Scale == 0 {
+		return 0, fmt.Errorf("invalid scale 0")
+	}
+
+	// encode the integer values without float conversion to keep the precision of 64-bit values
+	if s.Scale == 1 && s.Offset == 0 && !s.IsFloat {
+		if s.IsSigned {
+			if i, err := cast.ToInt64E(value); err == nil && float64(i) == physical {
+				return rawSigned(s, i)
+			}
+		} else if u, err := cast.ToUint64E(value); err == nil && float64(u) == physical {
+			return rawUnsigned(s, u)
+		}
+	}
+
+	raw := (physical - s.Offset) / s.Scale
+	if s.IsFloat {
+		switch s.Length {
+		case 32:
+			return uint64(math.Float32bits(float32(raw))), nil
+		case 64:
+			return math.Float64bits(raw), nil
+		}
+		return 0, fmt.Errorf("invalid float signal length %d", s.Length)
+	}
+	raw = math.Round(raw)
+	if s.IsSigned {
+		if raw < -math.Pow(2, float64(s.Length-1)) || raw >= math.Pow(2, float64(s.Length-1)) {
+			return 0, fmt.Errorf("value %v out of the range of %d-bit signed signal", value, s.Length)
+		}
+		return rawSigned(s, int64(raw))
+	}
+	if raw < 0 || raw >= math.Pow(2, float64(s.Length)) {
+		return 0, fmt.Errorf("value %v out of the range of %d-bit unsigned signal", value, s.Length)
+	}
+	return uint64(raw), nil
+}
+
+// labelValue returns the raw value of the value description, whose text label is the description or the description
+// prefixed by the signal name, e.g. Reverse or Gear_Reverse
+func labelValue(s *descriptor.Signal, label string) (int64, bool) {
+	for _, valueDescription := range s.ValueDescriptions {
+		if label == valueDescription.Description || label == s.Name+"_"+valueDescription.Description {
+			return valueDescription.Value, true
+		}
+	}
+	return 0, false
+}
+
+func rawSigned(s *descriptor.Signal, value int64) (uint64, error) {
+	if s.Length < 64 && (value < -(1<<(s.Length-1)) || value >= 1<<(s.Length-1)) {
+		return 0, fmt.Errorf("value %d out of the range of %d-bit signed signal", value, s.Length)
+	}
+	return uint64(value) & bitMask(s.Length), nil // #nosec G115
+}
+
+func rawUnsigned(s *descriptor.Signal, value uint64) (uint64, error) {
+	if value > bitMask(s.Length) {
+		return 0, fmt.Errorf("value %d out of the range of %d-bit unsigned signal", value, s.Length)
+	}
+	return value, nil
+}
+
+func floatValue(s *descriptor.Signal, raw uint64) float64 {
+	if s.Length == 32 {
+		return float64(math.Float32frombits(uint32(raw))) // #nosec G115
+	}
+	return math.Float64frombits(raw)
+}
+
+func signExtend(raw uint64, length uint8) int64 {
+	if length < 64 && raw&(1<<(length-1)) != 0 {
+		raw |= ^bitMask(length)
+	}
+	return int64(raw) // #nosec G115
+}
+
+func bitMask(length uint8) uint64 {
+	if length >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<length - 1
+}
+
+// signalBits returns the frame bit positions of the signal from the least significant bit
+// The start bit of little endian signals is the least significant bit, and the start bit of big endian signals is the
+// most significant bit, which continues at the most significant bit of the next byte after bit 0 of a byte
+func signalBits(s *descriptor.Signal, start uint16, size int) ([]int, error) {
+	if s.Length == 0 || s.Length > 64 {
+		return nil, fmt.Errorf("invalid signal length %d", s.Length)
+	}
+	positions := make([]int, s.Length)
+	position := int(start)
+	for i := range positions {
+		if s.IsBigEndian {
+			positions[len(positions)-1-i] = position
+			if position%8 == 0 {
+				position += 15
+			} else {
+				position--
+			}
+		} else {
+			positions[i] = position
+			position++
+		}
+	}
+	for _, position := range positions {
+		if position >= size*8 {
+			return nil, fmt.Errorf("signal bits %d|%d exceed the frame data size %d", start, s.Length, size)
+		}
+	}
+	return positions, nil
+}
+
+func (c *FrameCodec) readSignal(s *descriptor.Signal, data []byte) (uint64, error) {
+	positions, err := signalBits(s, c.extensions.StartBit(s), len(data))
+	if err != nil {
+		return 0, err
+	}
+	var raw uint64
+	for i, position := range positions {
+		if data[position/8]&(1<<(position%8)) != 0 {
+			raw |= 1 << i
+		}
+	}
+	return raw, nil
+}
+
+func (c *FrameCodec) writeSignal(s *descriptor.Signal, data []byte, raw uint64) error {
+	positions, err := signalBits(s, c.extensions.StartBit(s), len(data))
+	if err != nil {
+		return err
+	}
+	for i, position := range positions {
+		if raw&(1<<i) != 0 {
+			data[position/8] |= 1 << (position % 8)
+		} else {
+			data[position/8] &^= 1 << (position % 8)
+		}
+	}
+	return nil
+}
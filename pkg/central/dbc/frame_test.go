@@ -0,0 +1,233 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"math/rand"
+	"testing"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const testFrameDBC = `VERSION ""
+
+BU_: ECU Dashboard
+
+BO_ 2364539902 EEC2: 8 ECU
+ SG_ Pedal : 0|2@1+ (1,0) [0|3] "bit" Dashboard
+ SG_ Torque : 8|8@1- (1,0) [0|0] "%" Dashboard
+ SG_ Speed : 23|16@0+ (0.1,0) [0|6553.5] "km/h" Dashboard
+ SG_ Temperature : 39|8@0- (2,-40) [-296|214] "degC" Dashboard
+
+BO_ 291 Status: 64 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|255] "" Dashboard
+ SG_ Counter m1 : 8|32@1+ (1,0) [0|0] "" Dashboard
+ SG_ Level m2 : 8|16@1- (1,0) [0|0] "" Dashboard
+ SG_ Distance m1 : 255|64@0+ (1,0) [0|0] "m" Dashboard
+
+BO_ 292 Coolant: 8 ECU
+ SG_ CoolantTemp : 0|8@1+ (1,-40) [-40|215] "degC" Dashboard
+ SG_ Trim : 8|8@1+ (-1,0) [-255|0] "" Dashboard
+
+BA_DEF_ SG_  "GenSigStartValue" INT -2147483648 2147483647;
+BA_DEF_DEF_  "GenSigStartValue" 0;
+BA_ "GenSigStartValue" SG_ 291 Mode 1;
+BA_ "GenSigStartValue" SG_ 2364539902 Torque -5;
+`
+
+func newTestFrameCodec(t *testing.T) *FrameCodec {
+	codec, err := NewFrameCodecFromDBC([]byte(testFrameDBC))
+	require.NoError(t, err)
+	return codec
+}
+
+func readingValues(t *testing.T, codec *FrameCodec, canID uint32, data []byte) map[string]string {
+	readings, err := codec.Decode("device", canID, data)
+	require.NoError(t, err)
+	values := make(map[string]string, len(readings))
+	for _, reading := range readings {
+		values[reading.ResourceName] = reading.Value
+	}
+	return values
+}
+
+func TestFrameCodec_Decode(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	readings, err := codec.Decode("device", 2364539902, []byte{0x02, 0xfb, 0x04, 0xd2, 0x20, 0, 0, 0})
+	require.NoError(t, err)
+	require.Len(t, readings, 4)
+	assert.Equal(t, "EEC2", readings[0].ProfileName)
+	assert.Equal(t, "device", readings[0].DeviceName)
+	assert.Equal(t, edgexCommon.ValueTypeUint64, readings[0].ValueType)
+	assert.Equal(t, edgexCommon.ValueTypeInt64, readings[1].ValueType)
+	assert.Equal(t, edgexCommon.ValueTypeFloat64, readings[2].ValueType)
+
+	values := readingValues(t, codec, 2364539902, []byte{0x02, 0xfb, 0x04, 0xd2, 0x20, 0, 0, 0})
+	assert.Equal(t, "2", values["Pedal"])
+	assert.Equal(t, "-5", values["Torque"])
+	assert.Equal(t, "1.234e+02", values["Speed"])
+	assert.Equal(t, "24", values["Temperature"])
+
+	// the CAN ID without the extended flag
+	values = readingValues(t, codec, 0x0CF003FE, []byte{0x01, 0, 0, 0, 0, 0, 0, 0})
+	assert.Equal(t, "1", values["Pedal"])
+}
+
+func TestFrameCodec_DecodeMultiplexed(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	data := make([]byte, 64)
+	data[0] = 1
+	data[1], data[2], data[3], data[4] = 0x78, 0x56, 0x34, 0x12
+	data[31], data[38] = 0x80, 0x01
+	values := readingValues(t, codec, 291, data)
+	assert.Equal(t, map[string]string{"Mode": "1", "Counter": "305419896", "Distance": "9223372036854775809"}, values)
+
+	data[0] = 2
+	data[1], data[2] = 0xfe, 0xff
+	values = readingValues(t, codec, 291, data)
+	assert.Equal(t, map[string]string{"Mode": "2", "Level": "-2"}, values)
+
+	data[0] = 3
+	values = readingValues(t, codec, 291, data)
+	assert.Equal(t, map[string]string{"Mode": "3"}, values)
+}
+
+func TestFrameCodec_DecodeUnsignedNegativeOffset(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	readings, err := codec.Decode("device", 292, []byte{0x1e, 0x05, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	require.Len(t, readings, 2)
+	assert.Equal(t, edgexCommon.ValueTypeInt64, readings[0].ValueType)
+	assert.Equal(t, edgexCommon.ValueTypeInt64, readings[1].ValueType)
+	assert.Equal(t, map[string]string{"CoolantTemp": "-10", "Trim": "-5"}, readingValues(t, codec, 292, []byte{0x1e, 0x05, 0, 0, 0, 0, 0, 0}))
+	assert.Equal(t, map[string]string{"CoolantTemp": "215", "Trim": "-255"}, readingValues(t, codec, 292, []byte{0xff, 0xff, 0, 0, 0, 0, 0, 0}))
+
+	data, err := codec.Encode(292, map[string]any{"CoolantTemp": -40, "Trim": int64(-5)})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x05, 0, 0, 0, 0, 0, 0}, data)
+}
+
+func TestFrameCodec_DecodeErrors(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	_, err := codec.Decode("device", 1, make([]byte, 8))
+	require.Error(t, err)
+	_, err = codec.Decode("device", 291, make([]byte, 65))
+	require.Error(t, err)
+	_, err = codec.Decode("device", 291, []byte{1, 0, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err, "signal Distance exceeds the classic CAN frame")
+	_, err = codec.Decode("device", 2364539902, []byte{0, 0, 0})
+	require.Error(t, err)
+}
+
+func TestFrameCodec_Encode(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	data, err := codec.Encode(2364539902, map[string]any{"Pedal": 2, "Speed": "123.4", "Temperature": float64(24)})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0xfb, 0x04, 0xd2, 0x20, 0, 0, 0}, data)
+
+	data, err = codec.Encode(291, map[string]any{"Counter": uint32(305419896), "Distance": uint64(9223372036854775809)})
+	require.NoError(t, err)
+	require.Len(t, data, 64)
+	assert.Equal(t, map[string]string{"Mode": "1", "Counter": "305419896", "Distance": "9223372036854775809"}, readingValues(t, codec, 291, data))
+
+	data, err = codec.Encode(291, map[string]any{"Mode": 2, "Level": int16(-300)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Mode": "2", "Level": "-300"}, readingValues(t, codec, 291, data))
+}
+
+func TestFrameCodec_EncodeErrors(t *testing.T) {
+	codec := newTestFrameCodec(t)
+
+	tests := []struct {
+		name   string
+		canID  uint32
+		values map[string]any
+	}{
+		{"unknown message", 1, nil},
+		{"unknown signal", 291, map[string]any{"Unknown": 1}},
+		{"not selected by multiplexer", 291, map[string]any{"Mode": 2, "Counter": 1}},
+		{"invalid value", 2364539902, map[string]any{"Speed": "fast"}},
+		{"out of min max", 2364539902, map[string]any{"Pedal": 4}},
+		{"out of unsigned range", 291, map[string]any{"Counter": uint64(1) << 32}},
+		{"out of signed range", 2364539902, map[string]any{"Torque": 128}},
+		{"negative unsigned", 291, map[string]any{"Counter": -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := codec.Encode(tt.canID, tt.values)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSignalBits_MatchesClassicCAN(t *testing.T) {
+	random := rand.New(rand.NewSource(1)) // #nosec G404
+	for i := 0; i < 1000; i++ {
+		s := &descriptor.Signal{IsBigEndian: random.Intn(2) == 0, Length: uint8(random.Intn(64) + 1)} // #nosec G115
+		// the first bit of the signal in the frame, which is the most significant bit of big endian signals counting
+		// from bit 7 of byte 0
+		first := random.Intn(64 - int(s.Length) + 1)
+		if s.IsBigEndian {
+			s.Start = uint8(first/8*8 + 7 - first%8) // #nosec G115
+		} else {
+			s.Start = uint8(first) // #nosec G115
+		}
+		var data can.Data
+		random.Read(data[:])
+
+		codec := NewFrameCodec(nil, nil)
+		raw, err := codec.readSignal(s, data[:])
+		require.NoError(t, err)
+		require.Equal(t, s.UnmarshalUnsigned(data), raw, "signal %d|%d big endian %v", s.Start, s.Length, s.IsBigEndian)
+
+		var expected can.Data
+		s.MarshalUnsigned(&expected, raw)
+		result := make([]byte, 8)
+		require.NoError(t, codec.writeSignal(s, result, raw))
+		require.Equal(t, expected[:], result)
+	}
+}
+
+func TestFrameCodec_EncodeLabels(t *testing.T) {
+	codec, err := NewFrameCodecFromDBC([]byte(`VERSION ""
+
+BO_ 300 GearCommand: 8 Gateway
+ SG_ Gear : 0|4@1+ (1,0) [0|0] "" Transmission
+ SG_ Torque : 8|8@1- (1,0) [0|0] "" Transmission
+
+VAL_ 300 Gear 0 "Park" 1 "Drive" 2 "Reverse" ;
+VAL_ 300 Torque -1 "Error" ;
+`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		values   map[string]any
+		expected []byte
+	}{
+		{"label", map[string]any{"Gear": "Reverse"}, []byte{2, 0, 0, 0, 0, 0, 0, 0}},
+		{"label prefixed by signal name", map[string]any{"Gear": "Gear_Reverse"}, []byte{2, 0, 0, 0, 0, 0, 0, 0}},
+		{"numeric string", map[string]any{"Gear": "1"}, []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+		{"signed label", map[string]any{"Torque": "Error"}, []byte{0, 0xff, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := codec.Encode(300, tt.values)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+		})
+	}
+
+	_, err = codec.Encode(300, map[string]any{"Gear": "Neutral"})
+	require.Error(t, err)
+}
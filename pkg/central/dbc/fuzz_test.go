@@ -0,0 +1,48 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"os"
+	"testing"
+)
+
+func addFuzzSeeds(f *testing.F) {
+	sample, err := os.ReadFile("dbc_sample.dbc")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(sample)
+	f.Add([]byte(testExtensionsDBC))
+	f.Add([]byte(testFrameDBC))
+	f.Add([]byte(testDiagnosticsDBC))
+	f.Add([]byte(`VERSION ""
+
+BO_ 1 Message: 8 ECU
+ SG_ Signal : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BA_DEF_ SG_  "GenSigStartValue" INT 0 100;
+BA_ "GenSigStartValue" SG_ 1 Unknown 1;
+`))
+}
+
+func FuzzCompile(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = Compile("", data)
+	})
+}
+
+func FuzzConvertDBCtoProfile(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = ConvertDBCtoProfile(data)
+	})
+}
+
+func FuzzConvertDBCtoDevice(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can"})
+	})
+}
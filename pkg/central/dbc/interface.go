@@ -3,6 +3,7 @@
 package dbc
 
 import (
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -18,4 +19,15 @@ type Converter[T AllowedDTOTypes] interface {
 	GetDTOs() T
 	// GetValidateErrors returns the deviceName-validationError key-value map while parsing the DBC data to DTOs
 	GetValidateErrors() map[string]error
+	// GetDiagnostics returns the line-referenced diagnostics of the DBC definitions which are skipped or may not
+	// convert as expected, including the messages whose DTOs fail the validation
+	GetDiagnostics() []Diagnostic
+}
+
+// DeviceScheduleReader exposes the XRT Schedules of the converted Devices, looked up by device name, which poll the
+// resources by the AutoEvents intervals of the message cycle times
+// A Converter[[]edgexDtos.Device] returned from ConvertDBCtoDevice or ConvertDBCtoDeviceWithOptions implements this
+// interface; callers should type-assert to access it.
+type DeviceScheduleReader interface {
+	GetSchedulesByDeviceName(name string) []xrtmodels.Schedule
 }
@@ -0,0 +1,314 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"go.einride.tech/can"
+	"go.einride.tech/can/pkg/dbc"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// kcdNetworkDefinition is the root element of the Kayak KCD file, see https://github.com/dschanoeh/Kayak
+type kcdNetworkDefinition struct {
+	Nodes []kcdNode `xml:"Node"`
+	Buses []kcdBus  `xml:"Bus"`
+}
+
+type kcdNode struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+type kcdNodeRef struct {
+	ID string `xml:"id,attr"`
+}
+
+type kcdBus struct {
+	Name     string       `xml:"name,attr"`
+	Messages []kcdMessage `xml:"Message"`
+}
+
+type kcdMessage struct {
+	ID          string         `xml:"id,attr"`
+	Name        string         `xml:"name,attr"`
+	Length      string         `xml:"length,attr"`
+	Interval    string         `xml:"interval,attr"`
+	Triggered   string         `xml:"triggered,attr"`
+	Format      string         `xml:"format,attr"`
+	Notes       string         `xml:"Notes"`
+	Producers   []kcdNodeRef   `xml:"Producer>NodeRef"`
+	Multiplexes []kcdMultiplex `xml:"Multiplex"`
+	Signals     []kcdSignal    `xml:"Signal"`
+}
+
+type kcdSignal struct {
+	Name      string       `xml:"name,attr"`
+	Offset    string       `xml:"offset,attr"`
+	Length    string       `xml:"length,attr"`
+	Endianess string       `xml:"endianess,attr"`
+	Notes     string       `xml:"Notes"`
+	Consumers []kcdNodeRef `xml:"Consumer>NodeRef"`
+	Value     *kcdValue    `xml:"Value"`
+	Labels    []kcdLabel   `xml:"LabelSet>Label"`
+}
+
+type kcdMultiplex struct {
+	kcdSignal
+	MuxGroups []kcdMuxGroup `xml:"MuxGroup"`
+}
+
+type kcdMuxGroup struct {
+	Count   string      `xml:"count,attr"`
+	Signals []kcdSignal `xml:"Signal"`
+}
+
+type kcdValue struct {
+	Type      string `xml:"type,attr"`
+	Slope     string `xml:"slope,attr"`
+	Intercept string `xml:"intercept,attr"`
+	Unit      string `xml:"unit,attr"`
+	Min       string `xml:"min,attr"`
+	Max       string `xml:"max,attr"`
+}
+
+type kcdLabel struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// CompileKCD compiles the messages of all buses of the Kayak KCD file to the CAN database
+func CompileKCD(data []byte) (*CompileResult, error) {
+	var definition kcdNetworkDefinition
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&definition); err != nil {
+		return nil, fmt.Errorf("failed to parse KCD file: %w", err)
+	}
+
+	c := newCompiler()
+	nodes := make(map[string]string, len(definition.Nodes))
+	for _, node := range definition.Nodes {
+		nodes[node.ID] = node.Name
+		c.db.Nodes = append(c.db.Nodes, &descriptor.Node{Name: node.Name})
+	}
+	for _, bus := range definition.Buses {
+		for _, kcdMsg := range bus.Messages {
+			message, err := c.kcdMessage(kcdMsg, nodes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile KCD message '%s': %w", kcdMsg.Name, err)
+			}
+			c.db.Messages = append(c.db.Messages, message)
+		}
+	}
+	c.sortDescriptors()
+	c.checkDescriptors()
+	return c.result(), nil
+}
+
+func (c *compiler) kcdMessage(kcdMsg kcdMessage, nodes map[string]string) (*descriptor.Message, error) {
+	id, err := strconv.ParseUint(kcdMsg.ID, 0, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id '%s': %w", kcdMsg.ID, err)
+	}
+	message := &descriptor.Message{
+		Name:        kcdMsg.Name,
+		ID:          uint32(id),
+		IsExtended:  kcdMsg.Format == "extended" || id > 0x7FF,
+		Description: kcdMsg.Notes,
+		SenderNode:  defaultNode,
+	}
+	if len(kcdMsg.Producers) > 0 {
+		message.SenderNode = c.kcdNodeName(message, kcdMsg.Producers[0], nodes)
+	}
+	if kcdMsg.Interval != "" {
+		interval, err := strconv.ParseUint(kcdMsg.Interval, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval '%s': %w", kcdMsg.Interval, err)
+		}
+		message.CycleTime = time.Duration(interval) * time.Millisecond
+		message.SendType = descriptor.SendTypeCyclic
+	}
+	if kcdMsg.Triggered == "true" {
+		message.SendType = descriptor.SendTypeEvent
+	}
+
+	for _, multiplex := range kcdMsg.Multiplexes {
+		multiplexer, err := c.kcdSignal(message, multiplex.kcdSignal, nodes)
+		if err != nil {
+			return nil, err
+		}
+		multiplexer.IsMultiplexer = true
+		for _, muxGroup := range multiplex.MuxGroups {
+			count, err := strconv.ParseUint(muxGroup.Count, 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid count '%s' of multiplexer '%s': %w", muxGroup.Count, multiplexer.Name, err)
+			}
+			for _, kcdSig := range muxGroup.Signals {
+				signal, err := c.kcdSignal(message, kcdSig, nodes)
+				if err != nil {
+					return nil, err
+				}
+				signal.IsMultiplexed = true
+				signal.MultiplexerValue = uint(count)
+			}
+		}
+	}
+	for _, kcdSig := range kcdMsg.Signals {
+		if _, err := c.kcdSignal(message, kcdSig, nodes); err != nil {
+			return nil, err
+		}
+	}
+
+	length, err := kcdMessageLength(kcdMsg.Length, message, c.extensions)
+	if err != nil {
+		return nil, err
+	}
+	message.Length = length
+	// the KCD file doesn't define the frame format, the standard message longer than the classic CAN frame can only be
+	// sent as the CAN FD frame, while the longer extended message is sent by the J1939 transport protocol
+	if length > can.MaxDataLength && !message.IsExtended {
+		c.extensions.message(message).FrameFormat = frameFormats[frameFormatIndex(message)]
+	}
+	return message, nil
+}
+
+// kcdSignal adds the signal to the message, the offset of the big endian signal is the most significant bit counted
+// from the most significant bit of the first byte
+func (c *compiler) kcdSignal(message *descriptor.Message, kcdSig kcdSignal, nodes map[string]string) (*descriptor.Signal, error) {
+	offset, err := strconv.ParseUint(kcdSig.Offset, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset '%s' of signal '%s': %w", kcdSig.Offset, kcdSig.Name, err)
+	}
+	length := uint64(1)
+	if kcdSig.Length != "" {
+		if length, err = strconv.ParseUint(kcdSig.Length, 10, 8); err != nil || length == 0 || length > 64 {
+			return nil, fmt.Errorf("invalid length '%s' of signal '%s'", kcdSig.Length, kcdSig.Name)
+		}
+	}
+	signal := &descriptor.Signal{
+		Name:        kcdSig.Name,
+		Description: kcdSig.Notes,
+		IsBigEndian: kcdSig.Endianess == "big",
+		Length:      uint8(length),
+		Scale:       1,
+	}
+	start := offset
+	if signal.IsBigEndian {
+		start = 8*(offset/8) + 7 - offset%8
+	}
+	signal.Start = uint8(start) // #nosec G115
+	if start > uint64(^uint8(0)) {
+		c.extensions.signal(signal).StartBit = uint16(start)
+	}
+	for _, consumer := range kcdSig.Consumers {
+		signal.ReceiverNodes = append(signal.ReceiverNodes, c.kcdNodeName(message, consumer, nodes))
+	}
+	if kcdSig.Value != nil {
+		if err := c.setKCDValue(signal, *kcdSig.Value); err != nil {
+			return nil, err
+		}
+	}
+	for _, label := range kcdSig.Labels {
+		value, err := strconv.ParseInt(label.Value, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' of label '%s': %w", label.Value, label.Name, err)
+		}
+		signal.ValueDescriptions = append(signal.ValueDescriptions, &descriptor.ValueDescription{Value: value, Description: label.Name})
+	}
+	message.Signals = append(message.Signals, signal)
+	return signal, nil
+}
+
+func (c *compiler) setKCDValue(signal *descriptor.Signal, value kcdValue) error {
+	var err error
+	parse := func(s string, defaultValue float64) float64 {
+		if s == "" || err != nil {
+			return defaultValue
+		}
+		var f float64
+		if f, err = strconv.ParseFloat(s, 64); err != nil {
+			err = fmt.Errorf("invalid value '%s' of signal '%s': %w", s, signal.Name, err)
+		}
+		return f
+	}
+	signal.Scale = parse(value.Slope, 1)
+	signal.Offset = parse(value.Intercept, 0)
+	signal.Min = parse(value.Min, 0)
+	signal.Max = parse(value.Max, 0)
+	if err != nil {
+		return err
+	}
+	signal.Unit = value.Unit
+
+	switch value.Type {
+	case "signed":
+		signal.IsSigned = true
+	case "single", "double":
+		valueType := dbc.SignalValueTypeFloat32
+		if value.Type == "double" {
+			valueType = dbc.SignalValueTypeFloat64
+		}
+		if (valueType == dbc.SignalValueTypeFloat32 && signal.Length != 32) || (valueType == dbc.SignalValueTypeFloat64 && signal.Length != 64) {
+			return fmt.Errorf("signal '%s' length %d doesn't match the %s value type", signal.Name, signal.Length, value.Type)
+		}
+		signal.IsFloat = true
+		c.extensions.signal(signal).ValueType = valueType
+	}
+	return nil
+}
+
+func (c *compiler) kcdNodeName(message *descriptor.Message, ref kcdNodeRef, nodes map[string]string) string {
+	name, ok := nodes[ref.ID]
+	if !ok {
+		c.addDiagnostic(DiagnosticMissingNode, message.Name, fmt.Sprintf("node id '%s' is not declared", ref.ID))
+		return defaultNode
+	}
+	return name
+}
+
+// kcdMessageLength returns the message length, which is the length covering all signals if it is auto or unset
+func kcdMessageLength(length string, message *descriptor.Message, extensions *Extensions) (uint8, error) {
+	if length != "" && length != "auto" {
+		size, err := strconv.ParseUint(length, 10, 8)
+		if err != nil || size > MaxDataSize {
+			return 0, fmt.Errorf("invalid length '%s'", length)
+		}
+		return uint8(size), nil
+	}
+	size := 0
+	for _, s := range message.Signals {
+		positions, err := signalBits(s, extensions.StartBit(s), MaxDataSize)
+		if err != nil {
+			return 0, fmt.Errorf("invalid signal '%s': %w", s.Name, err)
+		}
+		for _, position := range positions {
+			size = max(size, position/8+1)
+		}
+	}
+	return uint8(size), nil // #nosec G115
+}
+
+// ConvertKCDtoProfile converts the messages of the KCD file selected by the options to DeviceProfile DTOs
+func ConvertKCDtoProfile(data []byte, options ImportOptions) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
+	compileResult, err := CompileKCD(data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to compile KCD file", err)
+	}
+	return convertDatabaseToProfile(compileResult, options)
+}
+
+// ConvertKCDtoDevice converts the messages of the KCD file selected by the options to Device DTOs
+func ConvertKCDtoDevice(data []byte, args map[string]string, options ImportOptions) (Converter[[]edgexDtos.Device], errors.EdgeX) {
+	compileResult, err := CompileKCD(data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to compile KCD file", err)
+	}
+	return convertDatabaseToDevice(compileResult, args, options)
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"testing"
+	"time"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const testKCD = `<?xml version="1.0" encoding="UTF-8"?>
+<NetworkDefinition xmlns="http://kayak.2codeornot2code.org/1.0">
+  <Document name="test"/>
+  <Node id="1" name="ECU"/>
+  <Node id="2" name="Dashboard"/>
+  <Bus name="Powertrain" baudrate="500000">
+    <Message id="0x0CF004FE" name="EEC1" length="8" interval="100" format="extended">
+      <Notes>Electronic engine controller 1</Notes>
+      <Producer><NodeRef id="1"/></Producer>
+      <Signal name="Torque" offset="16" length="8">
+        <Consumer><NodeRef id="2"/></Consumer>
+        <Value type="unsigned" slope="1" intercept="-125" unit="%" min="-125" max="125"/>
+      </Signal>
+      <Signal name="Speed" offset="24" length="16" endianess="little">
+        <Notes>Engine speed</Notes>
+        <Value slope="0.125" unit="rpm" min="0" max="8031.875"/>
+      </Signal>
+    </Message>
+    <Message id="0x123" name="Status" triggered="true">
+      <Producer><NodeRef id="2"/></Producer>
+      <Multiplex name="Mode" offset="0" length="8">
+        <MuxGroup count="1">
+          <Signal name="Level" offset="8" length="16" endianess="big">
+            <Value type="signed"/>
+          </Signal>
+        </MuxGroup>
+        <MuxGroup count="2">
+          <Signal name="Pressure" offset="8" length="32">
+            <Value type="single"/>
+          </Signal>
+        </MuxGroup>
+      </Multiplex>
+      <Signal name="Gear" offset="40" length="4">
+        <LabelSet>
+          <Label name="Reverse" value="2"/>
+          <Label name="Neutral" value="0"/>
+        </LabelSet>
+      </Signal>
+      <Signal name="Unknown" offset="44" length="4">
+        <Consumer><NodeRef id="9"/></Consumer>
+      </Signal>
+    </Message>
+  </Bus>
+</NetworkDefinition>`
+
+func TestCompileKCD(t *testing.T) {
+	result, err := CompileKCD([]byte(testKCD))
+	require.NoError(t, err)
+	db := result.Database
+	require.Len(t, db.Nodes, 2)
+	require.Len(t, db.Messages, 2)
+
+	status, eec1 := db.Messages[0], db.Messages[1]
+	assert.Equal(t, uint32(0x123), status.ID)
+	assert.False(t, status.IsExtended)
+	assert.Equal(t, uint8(6), status.Length, "auto length covers all signals")
+	assert.Equal(t, "Dashboard", status.SenderNode)
+	assert.Equal(t, descriptor.SendTypeEvent, status.SendType)
+
+	assert.Equal(t, uint32(0x0CF004FE), eec1.ID)
+	assert.True(t, eec1.IsExtended)
+	assert.Equal(t, uint8(8), eec1.Length)
+	assert.Equal(t, "ECU", eec1.SenderNode)
+	assert.Equal(t, "Electronic engine controller 1", eec1.Description)
+	assert.Equal(t, 100*time.Millisecond, eec1.CycleTime)
+
+	torque, ok := db.Signal(eec1.ID, "Torque")
+	require.True(t, ok)
+	assert.Equal(t, uint8(16), torque.Start)
+	assert.Equal(t, -125.0, torque.Offset)
+	assert.Equal(t, "%", torque.Unit)
+	assert.Equal(t, []string{"Dashboard"}, torque.ReceiverNodes)
+	speed, ok := db.Signal(eec1.ID, "Speed")
+	require.True(t, ok)
+	assert.Equal(t, 0.125, speed.Scale)
+	assert.Equal(t, "Engine speed", speed.Description)
+
+	mode, ok := db.Signal(status.ID, "Mode")
+	require.True(t, ok)
+	assert.True(t, mode.IsMultiplexer)
+	level, ok := db.Signal(status.ID, "Level")
+	require.True(t, ok)
+	assert.True(t, level.IsBigEndian)
+	assert.True(t, level.IsSigned)
+	assert.Equal(t, uint8(15), level.Start, "the most significant bit of the big endian signal")
+	assert.Equal(t, uint(1), level.MultiplexerValue)
+	pressure, ok := db.Signal(status.ID, "Pressure")
+	require.True(t, ok)
+	assert.True(t, pressure.IsFloat)
+	assert.Equal(t, uint(2), pressure.MultiplexerValue)
+	gear, ok := db.Signal(status.ID, "Gear")
+	require.True(t, ok)
+	require.Len(t, gear.ValueDescriptions, 2)
+	assert.Equal(t, "Neutral", gear.ValueDescriptions[0].Description)
+
+	require.Len(t, result.Diagnostics, 1)
+	assert.Equal(t, DiagnosticMissingNode, result.Diagnostics[0].Code)
+	assert.Equal(t, "Status", result.Diagnostics[0].MessageName)
+}
+
+func TestConvertKCD(t *testing.T) {
+	profileConverter, err := ConvertKCDtoProfile([]byte(testKCD), ImportOptions{})
+	require.NoError(t, err)
+	profiles := profileConverter.GetDTOs()
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "Status", profiles[0].Name)
+	for _, resource := range profiles[0].DeviceResources {
+		if resource.Name == "Pressure" {
+			assert.Equal(t, edgexCommon.ValueTypeFloat32, resource.Properties.ValueType)
+		}
+	}
+	require.Len(t, profiles[0].DeviceCommands, 1)
+	assert.Equal(t, "Reverse", profiles[0].DeviceCommands[0].ResourceOperations[0].Mappings["2"])
+
+	deviceConverter, err := ConvertKCDtoDevice([]byte(testKCD), map[string]string{ServiceName: "device-can"}, ImportOptions{Senders: []string{"ECU"}})
+	require.NoError(t, err)
+	devices := deviceConverter.GetDTOs()
+	require.Len(t, devices, 1)
+	assert.Equal(t, "EEC1", devices[0].Name)
+	assert.Equal(t, J1939, devices[0].Protocols[Canbus][Standard])
+	assert.Equal(t, "100ms", devices[0].AutoEvents[0].Interval)
+
+	codec := NewFrameCodec(mustCompileKCD(t).Database, nil)
+	data, encodeErr := codec.Encode(0x123, map[string]any{"Mode": 1, "Level": -2, "Gear": 2})
+	require.NoError(t, encodeErr)
+	assert.Equal(t, []byte{1, 0xff, 0xfe, 0, 0, 2}, data)
+
+	_, err = ConvertKCDtoProfile([]byte("<NetworkDefinition><Bus><Message id=\"x\"/></Bus></NetworkDefinition>"), ImportOptions{})
+	require.Error(t, err)
+	_, err = ConvertKCDtoProfile([]byte("not xml"), ImportOptions{})
+	require.Error(t, err)
+}
+
+func TestCompileKCD_LongMessages(t *testing.T) {
+	result, err := CompileKCD([]byte(`<NetworkDefinition xmlns="http://kayak.2codeornot2code.org/1.0">
+  <Node id="1" name="ECU"/>
+  <Bus name="Powertrain">
+    <Message id="0x18FECA00" name="DM1" length="20" format="extended">
+      <Producer><NodeRef id="1"/></Producer>
+      <Signal name="Lamps" offset="0" length="16"/>
+    </Message>
+    <Message id="0x200" name="Status" length="20">
+      <Producer><NodeRef id="1"/></Producer>
+      <Signal name="Mode" offset="0" length="8"/>
+    </Message>
+  </Bus>
+</NetworkDefinition>`))
+	require.NoError(t, err)
+	dm1, ok := result.Database.Message(0x18FECA00)
+	require.True(t, ok)
+	status, ok := result.Database.Message(0x200)
+	require.True(t, ok)
+	assert.False(t, result.Extensions.IsFD(dm1), "the long extended message is sent by the transport protocol")
+	assert.True(t, isMultiPacket(dm1, result.Extensions.IsFD(dm1)))
+	assert.True(t, result.Extensions.IsFD(status), "the long standard message can only be sent as CAN FD")
+
+	profileConverter, err := ConvertKCDtoProfile([]byte(`<NetworkDefinition>
+  <Bus name="Powertrain">
+    <Message id="0x18FECA00" name="DM1" length="20" format="extended">
+      <Signal name="Lamps" offset="0" length="16"/>
+    </Message>
+  </Bus>
+</NetworkDefinition>`), ImportOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, true, profileConverter.GetDTOs()[0].DeviceResources[0].Attributes[MultiPacket])
+}
+
+func mustCompileKCD(t *testing.T) *CompileResult {
+	result, err := CompileKCD([]byte(testKCD))
+	require.NoError(t, err)
+	return result
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// ImportOptions selects the DBC messages to convert and how they are converted, all messages are converted to
+// read-only resources by the zero value
+// A message is selected if it matches all the set filters; the Senders and Receivers filters are matched by either
+// node list, so that the messages sent to or from the listed nodes are selected
+type ImportOptions struct {
+	// Senders are the names of the nodes sending the selected messages
+	Senders []string
+	// Receivers are the names of the nodes receiving any signal of the selected messages
+	Receivers []string
+	// IDRanges are the CAN ID ranges of the selected messages, the CAN IDs exclude the extended flag of the DBC file
+	IDRanges []IDRange
+	// NamePatterns match the names of the selected messages
+	NamePatterns []*regexp.Regexp
+	// GroupBySender converts the messages of the same sender node to one DeviceProfile and Device named by the node,
+	// whose resources are named by the message and signal names, e.g. EEC1_EngineSpeed
+	// The grouped DeviceProfiles and Devices can't be converted back by ConvertProfilesToDBC
+	GroupBySender bool
+	// Node is the name of the gateway node, the signals received by the node are writable, and their value
+	// descriptions can be written by the text labels, which are mapped to the raw values by the labelValues attribute
+	Node string
+}
+
+// IDRange is an inclusive range of CAN IDs
+type IDRange struct {
+	From uint32
+	To   uint32
+}
+
+// Contains returns whether the CAN ID is in the range
+func (r IDRange) Contains(canID uint32) bool {
+	return canID >= r.From && canID <= r.To
+}
+
+// match returns whether the message is selected by the options
+func (o ImportOptions) match(m *descriptor.Message) bool {
+	if len(o.Senders) > 0 || len(o.Receivers) > 0 {
+		if !slices.Contains(o.Senders, m.SenderNode) && !o.received(m) {
+			return false
+		}
+	}
+	if len(o.IDRanges) > 0 && !slices.ContainsFunc(o.IDRanges, func(r IDRange) bool { return r.Contains(m.ID) }) {
+		return false
+	}
+	if len(o.NamePatterns) > 0 &&
+		!slices.ContainsFunc(o.NamePatterns, func(pattern *regexp.Regexp) bool { return pattern.MatchString(m.Name) }) {
+		return false
+	}
+	return true
+}
+
+func (o ImportOptions) received(m *descriptor.Message) bool {
+	for _, s := range m.Signals {
+		for _, receiver := range s.ReceiverNodes {
+			if slices.Contains(o.Receivers, receiver) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readWrite returns the ReadWrite of the signal resource, which is writable if the signal is received by the node
+func (o ImportOptions) readWrite(s *descriptor.Signal) string {
+	if o.Node != "" && slices.Contains(s.ReceiverNodes, o.Node) {
+		return edgexCommon.ReadWrite_RW
+	}
+	return edgexCommon.ReadWrite_R
+}
+
+// selectMessages returns the messages selected by the options
+func (o ImportOptions) selectMessages(messages []*descriptor.Message) []*descriptor.Message {
+	selected := make([]*descriptor.Message, 0, len(messages))
+	for _, m := range messages {
+		if o.match(m) {
+			selected = append(selected, m)
+		}
+	}
+	return selected
+}
+
+// groupBySender groups the messages by the sender node in the order of the first message of each sender
+func groupBySender(messages []*descriptor.Message) [][]*descriptor.Message {
+	var groups [][]*descriptor.Message
+	indexes := make(map[string]int)
+	for _, m := range messages {
+		index, ok := indexes[m.SenderNode]
+		if !ok {
+			index = len(groups)
+			indexes[m.SenderNode] = index
+			groups = append(groups, nil)
+		}
+		groups[index] = append(groups[index], m)
+	}
+	return groups
+}
+
+// resourceName returns the resource name of the signal, which is prefixed by the message name if the messages are
+// grouped by sender
+func resourceName(m *descriptor.Message, signalName string, grouped bool) string {
+	if !grouped {
+		return signalName
+	}
+	return fmt.Sprintf("%s_%s", m.Name, signalName)
+}
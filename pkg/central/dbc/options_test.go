@@ -0,0 +1,159 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"regexp"
+	"testing"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOptionsDBC = `VERSION ""
+
+BU_: Engine Brake Dashboard Gateway
+
+BO_ 100 EngineSpeed: 8 Engine
+ SG_ Speed : 0|16@1+ (1,0) [0|0] "rpm" Dashboard
+
+BO_ 101 EngineTemp: 8 Engine
+ SG_ Temperature : 0|8@1+ (1,-40) [0|0] "degC" Gateway
+
+BO_ 200 BrakeStatus: 8 Brake
+ SG_ Mode M : 0|8@1+ (1,0) [0|0] "" Dashboard
+ SG_ Pressure m1 : 8|16@1+ (1,0) [0|0] "bar" Dashboard
+
+BO_ 2566844926 BrakeDiagnostics: 8 Brake
+ SG_ Code : 0|16@1+ (1,0) [0|0] "" Gateway
+
+BA_DEF_ BO_  "GenMsgCycleTime" INT 0 65535;
+BA_DEF_DEF_  "GenMsgCycleTime" 100;
+`
+
+func TestConvertDBC_ImportOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  ImportOptions
+		expected []string
+	}{
+		{"all", ImportOptions{}, []string{"EngineSpeed", "EngineTemp", "BrakeStatus", "BrakeDiagnostics"}},
+		{"senders", ImportOptions{Senders: []string{"Brake"}}, []string{"BrakeStatus", "BrakeDiagnostics"}},
+		{"receivers", ImportOptions{Receivers: []string{"Gateway"}}, []string{"EngineTemp", "BrakeDiagnostics"}},
+		{"senders or receivers", ImportOptions{Senders: []string{"Engine"}, Receivers: []string{"Gateway"}},
+			[]string{"EngineSpeed", "EngineTemp", "BrakeDiagnostics"}},
+		{"ID ranges", ImportOptions{IDRanges: []IDRange{{From: 100, To: 100}, {From: 0x18000000, To: 0x1FFFFFFF}}},
+			[]string{"EngineSpeed", "BrakeDiagnostics"}},
+		{"name patterns", ImportOptions{NamePatterns: []*regexp.Regexp{regexp.MustCompile(`^Engine`), regexp.MustCompile(`Status$`)}},
+			[]string{"EngineSpeed", "EngineTemp", "BrakeStatus"}},
+		{"all filters", ImportOptions{Senders: []string{"Engine"}, IDRanges: []IDRange{{From: 0, To: 150}},
+			NamePatterns: []*regexp.Regexp{regexp.MustCompile(`Temp`)}}, []string{"EngineTemp"}},
+		{"no match", ImportOptions{Senders: []string{"Unknown"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileConverter, err := ConvertDBCtoProfileWithOptions([]byte(testOptionsDBC), tt.options)
+			require.NoError(t, err)
+			deviceConverter, err := ConvertDBCtoDeviceWithOptions([]byte(testOptionsDBC), map[string]string{ServiceName: "device-can"}, tt.options)
+			require.NoError(t, err)
+
+			var profileNames, deviceNames []string
+			for _, profile := range profileConverter.GetDTOs() {
+				profileNames = append(profileNames, profile.Name)
+			}
+			for _, device := range deviceConverter.GetDTOs() {
+				deviceNames = append(deviceNames, device.Name)
+			}
+			assert.ElementsMatch(t, tt.expected, profileNames)
+			assert.ElementsMatch(t, tt.expected, deviceNames)
+		})
+	}
+}
+
+func TestConvertDBC_GroupBySender(t *testing.T) {
+	options := ImportOptions{GroupBySender: true}
+	profileConverter, err := ConvertDBCtoProfileWithOptions([]byte(testOptionsDBC), options)
+	require.NoError(t, err)
+	profiles := profileConverter.GetDTOs()
+	require.Len(t, profiles, 2)
+
+	engine := profiles[0]
+	assert.Equal(t, "Engine", engine.Name)
+	require.Len(t, engine.DeviceResources, 2)
+	assert.Equal(t, "EngineSpeed_Speed", engine.DeviceResources[0].Name)
+	assert.Equal(t, "100", engine.DeviceResources[0].Attributes[ID])
+	assert.Equal(t, uint8(8), engine.DeviceResources[0].Attributes[DataSize])
+	assert.Equal(t, "EngineTemp_Temperature", engine.DeviceResources[1].Name)
+	assert.Equal(t, "101", engine.DeviceResources[1].Attributes[ID])
+	assert.NotContains(t, engine.DeviceResources[1].Attributes, PGN)
+
+	brake := profiles[1]
+	assert.Equal(t, "Brake", brake.Name)
+	require.Len(t, brake.DeviceResources, 3)
+	assert.Equal(t, "BrakeStatus_Mode", brake.DeviceResources[0].Name)
+	assert.Equal(t, "BrakeDiagnostics_Code", brake.DeviceResources[2].Name)
+	assert.Equal(t, "2566844926", brake.DeviceResources[2].Attributes[ID])
+	// the J1939 properties of each message are kept in the grouped resources
+	assert.Equal(t, J1939, brake.DeviceResources[2].Attributes[Standard])
+	assert.Equal(t, "6", brake.DeviceResources[2].Attributes[Priority])
+	assert.Equal(t, "254", brake.DeviceResources[2].Attributes[SourceAddress])
+	assert.Equal(t, PDUFormat2, brake.DeviceResources[2].Attributes[PDUFormat])
+	assert.Equal(t, "FEF1", brake.DeviceResources[2].Attributes[PGN])
+	assert.NotContains(t, brake.DeviceResources[0].Attributes, Priority)
+
+	deviceConverter, err := ConvertDBCtoDeviceWithOptions([]byte(testOptionsDBC), map[string]string{ServiceName: "device-can"}, options)
+	require.NoError(t, err)
+	devices := deviceConverter.GetDTOs()
+	require.Len(t, devices, 2)
+	assert.Equal(t, "Engine", devices[0].Name)
+	assert.Equal(t, "Engine", devices[0].ProfileName)
+	assert.Equal(t, CAN, devices[0].Protocols[Canbus][Standard])
+	assert.Equal(t, "Engine", devices[0].Protocols[Canbus][Sender])
+	assert.NotContains(t, devices[0].Protocols[Canbus], ID)
+	require.Len(t, devices[0].AutoEvents, 2)
+	assert.Equal(t, "EngineSpeed_Speed", devices[0].AutoEvents[0].SourceName)
+	assert.Equal(t, "EngineTemp_Temperature", devices[0].AutoEvents[1].SourceName)
+	assert.Equal(t, "Brake", devices[1].Name)
+	assert.Len(t, devices[1].AutoEvents, 3)
+}
+
+func TestIDRange_Contains(t *testing.T) {
+	r := IDRange{From: 0x100, To: 0x1FF}
+	assert.True(t, r.Contains(0x100))
+	assert.True(t, r.Contains(0x1FF))
+	assert.False(t, r.Contains(0xFF))
+	assert.False(t, r.Contains(0x200))
+}
+
+func TestConvertDBC_WritableNode(t *testing.T) {
+	data := []byte(`VERSION ""
+
+BU_: Gateway Transmission
+
+BO_ 300 GearCommand: 8 Gateway
+ SG_ Gear : 0|4@1+ (1,0) [0|0] "" Transmission
+ SG_ Counter : 8|8@1+ (1,0) [0|0] "" Gateway
+
+VAL_ 300 Gear 0 "Gear_Park" 1 "Gear_Drive" 2 "Gear_Reverse" ;
+`)
+	profileConverter, err := ConvertDBCtoProfileWithOptions(data, ImportOptions{Node: "Transmission"})
+	require.NoError(t, err)
+	profiles := profileConverter.GetDTOs()
+	require.Len(t, profiles, 1)
+	resources := profiles[0].DeviceResources
+	assert.Equal(t, edgexCommon.ReadWrite_RW, resources[0].Properties.ReadWrite)
+	assert.Equal(t, edgexCommon.ReadWrite_R, resources[1].Properties.ReadWrite)
+	require.Len(t, profiles[0].DeviceCommands, 1)
+	command := profiles[0].DeviceCommands[0]
+	assert.Equal(t, edgexCommon.ReadWrite_RW, command.ReadWrite)
+	assert.Equal(t, map[string]string{"0": "Gear_Park", "1": "Gear_Drive", "2": "Gear_Reverse"}, command.ResourceOperations[0].Mappings)
+	assert.Equal(t, map[string]string{"Gear_Park": "0", "Gear_Drive": "1", "Gear_Reverse": "2"}, resources[0].Attributes[LabelValues])
+
+	profileConverter, err = ConvertDBCtoProfile(data)
+	require.NoError(t, err)
+	assert.Equal(t, edgexCommon.ReadWrite_R, profileConverter.GetDTOs()[0].DeviceResources[0].Properties.ReadWrite)
+	assert.Equal(t, edgexCommon.ReadWrite_R, profileConverter.GetDTOs()[0].DeviceCommands[0].ReadWrite)
+	assert.NotContains(t, profileConverter.GetDTOs()[0].DeviceResources[0].Attributes, LabelValues, "read-only resource has no reverse mappings")
+}
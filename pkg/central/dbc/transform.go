@@ -15,12 +15,19 @@ import (
 )
 
 func valueType(s *descriptor.Signal) string {
+	if s.IsFloat {
+		if s.Length == 32 && s.Scale == 1 && s.Offset == 0 {
+			return edgexCommon.ValueTypeFloat32
+		}
+		return edgexCommon.ValueTypeFloat64
+	}
 	_, offsetFrac := math.Modf(s.Offset)
 	_, scaleFrac := math.Modf(s.Scale)
 	if offsetFrac != 0 || scaleFrac != 0 {
 		return edgexCommon.ValueTypeFloat64
 	}
-	if s.IsSigned {
+	// the unsigned signal with a negative scale or offset has negative physical values
+	if s.IsSigned || s.Scale < 0 || s.Offset < 0 {
 		return edgexCommon.ValueTypeInt64
 	} else {
 		return edgexCommon.ValueTypeUint64
@@ -28,12 +35,23 @@ func valueType(s *descriptor.Signal) string {
 }
 
 func ConvertDBCtoProfile(data []byte) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
-	converter, err := newDeviceProfileDBC(data)
+	return ConvertDBCtoProfileWithOptions(data, ImportOptions{})
+}
+
+// ConvertDBCtoProfileWithOptions converts the DBC messages selected by the options to DeviceProfile DTOs, one per
+// message or one per sender node if GroupBySender is set
+func ConvertDBCtoProfileWithOptions(data []byte, options ImportOptions) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
+	compileResult, err := Compile("", data)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to compile DBC file", err)
 	}
+	return convertDatabaseToProfile(compileResult, options)
+}
 
-	err = converter.ConvertToDTO()
+// convertDatabaseToProfile converts the compiled messages of the DBC, KCD or ARXML file to DeviceProfile DTOs
+func convertDatabaseToProfile(compileResult *CompileResult, options ImportOptions) (Converter[[]edgexDtos.DeviceProfile], errors.EdgeX) {
+	converter := newDeviceProfileDBC(compileResult, options)
+	err := converter.ConvertToDTO()
 	if err != nil {
 		return nil, err
 	}
@@ -42,12 +60,23 @@ func ConvertDBCtoProfile(data []byte) (Converter[[]edgexDtos.DeviceProfile], err
 }
 
 func ConvertDBCtoDevice(data []byte, args map[string]string) (Converter[[]edgexDtos.Device], errors.EdgeX) {
-	converter, err := newDeviceDBC(data, args)
+	return ConvertDBCtoDeviceWithOptions(data, args, ImportOptions{})
+}
+
+// ConvertDBCtoDeviceWithOptions converts the DBC messages selected by the options to Device DTOs, one per message or
+// one per sender node if GroupBySender is set
+func ConvertDBCtoDeviceWithOptions(data []byte, args map[string]string, options ImportOptions) (Converter[[]edgexDtos.Device], errors.EdgeX) {
+	compileResult, err := Compile("", data)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to compile DBC file", err)
 	}
+	return convertDatabaseToDevice(compileResult, args, options)
+}
 
-	err = converter.ConvertToDTO()
+// convertDatabaseToDevice converts the compiled messages of the DBC, KCD or ARXML file to Device DTOs
+func convertDatabaseToDevice(compileResult *CompileResult, args map[string]string, options ImportOptions) (Converter[[]edgexDtos.Device], errors.EdgeX) {
+	converter := newDeviceDBC(compileResult, args, options)
+	err := converter.ConvertToDTO()
 	if err != nil {
 		return nil, err
 	}
@@ -55,13 +84,82 @@ func ConvertDBCtoDevice(data []byte, args map[string]string) (Converter[[]edgexD
 	return converter, nil
 }
 
-func getOriginalCanId(canID uint32) string {
-	id := canID | messageIDExtendedFlag
+// ConvertProfilesToDBC converts the DeviceProfiles of CAN messages and the Devices using them to the DBC file content
+// It returns the profileName-error map of the DeviceProfiles which can't be converted
+func ConvertProfilesToDBC(profiles []edgexDtos.DeviceProfile, devices []edgexDtos.Device) ([]byte, map[string]error, errors.EdgeX) {
+	db, extensions, validateErrors := convertDTOsToDatabase(profiles, devices)
+	data := formatDBC(db, extensions)
+	if _, err := Compile("", data); err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to write a valid DBC file", err)
+	}
+	return data, validateErrors, nil
+}
+
+// getOriginalCanId returns the CAN ID of the DBC file, which has the most significant bit set for extended CAN IDs
+func getOriginalCanId(canID uint32, isExtended bool) string {
+	id := canID
+	if isExtended {
+		id |= messageIDExtendedFlag
+	}
 	return strconv.FormatUint(uint64(id), 10)
 }
 
 func getPGN(canID uint32) string {
 	// J1939 PGN bit start from 9, length is 18
 	pgn := (canID >> j1939PGNOffset) & j1939PGNMask
+	if !isPDU2(canID) {
+		// the PDU specific field of PDU1 messages is the destination address rather than a part of the PGN
+		pgn &^= 0xFF
+	}
 	return fmt.Sprintf("%X", pgn)
 }
+
+// isPDU2 returns whether the J1939 CAN ID has the PDU2 format, which is broadcast and has no destination address
+func isPDU2(canID uint32) bool {
+	return (canID>>j1939PFOffset)&0xFF >= j1939PDU2MinPF
+}
+
+// setJ1939Properties sets the J1939 standard, priority, source address and PDU format of the extended CAN ID, and
+// the destination address of the PDU1 format
+func setJ1939Properties(properties edgexDtos.ProtocolProperties, canID uint32) {
+	properties[Standard] = J1939
+	properties[Priority] = strconv.FormatUint(uint64((canID>>j1939PriorityOffset)&j1939PriorityMask), 10)
+	properties[SourceAddress] = strconv.FormatUint(uint64(canID&0xFF), 10)
+	if isPDU2(canID) {
+		properties[PDUFormat] = PDUFormat2
+	} else {
+		properties[PDUFormat] = PDUFormat1
+		properties[DestinationAddress] = strconv.FormatUint(uint64((canID>>j1939PGNOffset)&0xFF), 10)
+	}
+}
+
+// autoEvents returns the AutoEvents of the message signals, whose interval is the message cycle time
+// The event messages are read on change, by the cycle time, the delay time or the default interval
+func autoEvents(m *descriptor.Message, grouped bool) []edgexDtos.AutoEvent {
+	interval := m.CycleTime
+	onChange := m.SendType == descriptor.SendTypeEvent
+	if onChange && interval <= 0 {
+		interval = m.DelayTime
+		if interval <= 0 {
+			interval = defaultEventInterval
+		}
+	}
+	if interval <= 0 {
+		return nil
+	}
+	autoEvents := make([]edgexDtos.AutoEvent, 0, len(m.Signals))
+	for _, s := range m.Signals {
+		autoEvents = append(autoEvents, edgexDtos.AutoEvent{
+			Interval:   interval.String(),
+			OnChange:   onChange,
+			SourceName: resourceName(m, s.Name, grouped),
+		})
+	}
+	return autoEvents
+}
+
+// isMultiPacket returns whether the J1939 message is longer than a single frame and sent by the transport protocol
+// The CAN FD frames carry up to 64 bytes in a single frame
+func isMultiPacket(m *descriptor.Message, isFD bool) bool {
+	return m.IsExtended && m.Length > j1939MaxSingleFrame && (!isFD || m.Length > MaxDataSize)
+}
@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2024 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package dbc
 
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -55,14 +56,17 @@ func TestConvertDBCtoDevice(t *testing.T) {
 		ServiceName:    serviceName,
 		Protocols: map[string]edgexDtos.ProtocolProperties{
 			Canbus: {
-				NetType:  netType,
-				Network:  networkName,
-				CommType: commType,
-				Port:     port,
-				Standard: J1939,
-				ID:       "2364539902",
-				DataSize: "8",
-				Sender:   "Vector__XXX",
+				NetType:       netType,
+				Network:       networkName,
+				CommType:      commType,
+				Port:          port,
+				Standard:      J1939,
+				ID:            "2364539902",
+				DataSize:      "8",
+				Sender:        "Vector__XXX",
+				Priority:      "3",
+				SourceAddress: "254",
+				PDUFormat:     PDUFormat2,
 			},
 		},
 		Tags: map[string]any{
@@ -72,6 +76,61 @@ func TestConvertDBCtoDevice(t *testing.T) {
 	require.EqualValues(t, expectedDeviceDTO, deviceDTOs[0], "Generated Device DTO doesn't match the expected value.")
 }
 
+func TestConvertDBCtoDevice_J1939(t *testing.T) {
+	data := []byte(`VERSION ""
+
+BU_: ECU
+
+BO_ 291 Standard: 8 ECU
+ SG_ Speed : 0|16@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 2565472505 Request: 3 ECU
+ SG_ RequestedPGN : 0|24@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 2566841342 Configuration: 40 ECU
+ SG_ Torque : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+`)
+
+	deviceConverter, err := ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can"})
+	require.NoError(t, err)
+	require.Empty(t, deviceConverter.GetValidateErrors())
+	devices := deviceConverter.GetDTOs()
+	require.Len(t, devices, 3)
+
+	tests := []struct {
+		name               string
+		expectedProperties edgexDtos.ProtocolProperties
+		expectedTags       map[string]any
+	}{
+		{"Standard", edgexDtos.ProtocolProperties{Standard: CAN, ID: "291"}, nil},
+		{"Request", edgexDtos.ProtocolProperties{Standard: J1939, ID: "2565472505", Priority: "6", SourceAddress: "249",
+			DestinationAddress: "0", PDUFormat: PDUFormat1}, map[string]any{PGN: "EA00"}},
+		{"Configuration", edgexDtos.ProtocolProperties{Standard: J1939, ID: "2566841342", Priority: "6", SourceAddress: "254",
+			PDUFormat: PDUFormat2}, map[string]any{PGN: "FEE3"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := devices[i]
+			require.Equal(t, tt.name, device.Name)
+			for key, value := range tt.expectedProperties {
+				require.Equal(t, value, device.Protocols[Canbus][key], key)
+			}
+			if _, ok := tt.expectedProperties[DestinationAddress]; !ok {
+				require.NotContains(t, device.Protocols[Canbus], DestinationAddress)
+			}
+			require.Equal(t, tt.expectedTags, device.Tags)
+		})
+	}
+
+	profileConverter, err := ConvertDBCtoProfile(data)
+	require.NoError(t, err)
+	profiles := profileConverter.GetDTOs()
+	require.Len(t, profiles, 3)
+	require.NotContains(t, profiles[0].DeviceResources[0].Attributes, MultiPacket)
+	require.NotContains(t, profiles[1].DeviceResources[0].Attributes, MultiPacket)
+	require.Equal(t, true, profiles[2].DeviceResources[0].Attributes[MultiPacket])
+}
+
 func TestConvertDBCtoProfile(t *testing.T) {
 	testMinimum := float64(0)
 	testMaximum := float64(3)
@@ -151,3 +210,68 @@ func TestConvertDBCtoProfile(t *testing.T) {
 		t.Errorf("Generated DeviceProfile DTO doesn't match the expected value.")
 	}
 }
+
+func TestConvertDBCtoDevice_AutoEvents(t *testing.T) {
+	data := []byte(`VERSION ""
+
+BU_: ECU
+
+BO_ 1 Cyclic: 8 ECU
+ SG_ Speed : 0|16@1+ (1,0) [0|0] "" Vector__XXX
+ SG_ Torque : 16|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 2 Event: 8 ECU
+ SG_ Gear : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 3 Delayed: 8 ECU
+ SG_ Door : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BO_ 4 None: 8 ECU
+ SG_ Mode : 0|8@1+ (1,0) [0|0] "" Vector__XXX
+
+BA_DEF_ BO_  "GenMsgSendType" ENUM  "Cyclic","Event";
+BA_DEF_ BO_  "GenMsgCycleTime" INT 0 65535;
+BA_DEF_ BO_  "GenMsgDelayTime" INT 0 65535;
+BA_DEF_DEF_  "GenMsgSendType" "Cyclic";
+BA_DEF_DEF_  "GenMsgCycleTime" 0;
+BA_DEF_DEF_  "GenMsgDelayTime" 0;
+BA_ "GenMsgCycleTime" BO_ 1 100;
+BA_ "GenMsgSendType" BO_ 2 1;
+BA_ "GenMsgSendType" BO_ 3 1;
+BA_ "GenMsgDelayTime" BO_ 3 50;
+`)
+
+	deviceConverter, err := ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can"})
+	require.NoError(t, err)
+	devices := deviceConverter.GetDTOs()
+	require.Len(t, devices, 4)
+
+	require.Equal(t, []edgexDtos.AutoEvent{
+		{Interval: "100ms", SourceName: "Speed"},
+		{Interval: "100ms", SourceName: "Torque"},
+	}, devices[0].AutoEvents)
+	require.Equal(t, []edgexDtos.AutoEvent{{Interval: "1s", OnChange: true, SourceName: "Gear"}}, devices[1].AutoEvents)
+	require.Equal(t, []edgexDtos.AutoEvent{{Interval: "50ms", OnChange: true, SourceName: "Door"}}, devices[2].AutoEvents)
+	require.Empty(t, devices[3].AutoEvents)
+
+	// the AutoEvents of the same interval are merged into one XRT Schedule
+	scheduleReader, ok := deviceConverter.(DeviceScheduleReader)
+	require.True(t, ok)
+	require.Equal(t, []xrtmodels.Schedule{{
+		Name:     "Cyclic-schedule-1",
+		Device:   "Cyclic",
+		Resource: []string{"Speed", "Torque"},
+		Interval: 100000,
+		Publish:  true,
+	}}, scheduleReader.GetSchedulesByDeviceName("Cyclic"))
+	require.Equal(t, []xrtmodels.Schedule{{
+		Name:     "Delayed-schedule-1",
+		Device:   "Delayed",
+		Resource: []string{"Door"},
+		Interval: 50000,
+		OnChange: true,
+		Publish:  true,
+	}}, scheduleReader.GetSchedulesByDeviceName("Delayed"))
+	require.Empty(t, scheduleReader.GetSchedulesByDeviceName("None"))
+	require.Empty(t, scheduleReader.GetSchedulesByDeviceName("Unknown"))
+}
@@ -0,0 +1,507 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/spf13/cast"
+	"go.einride.tech/can/pkg/dbc"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+const (
+	// defaultNode is the placeholder node of the DBC files for messages and signals without sender or receiver
+	defaultNode = "Vector__XXX"
+
+	attributeGenSigStartValue = "GenSigStartValue"
+	attributeGenMsgSendType   = "GenMsgSendType"
+	attributeGenMsgCycleTime  = "GenMsgCycleTime"
+)
+
+// newSymbols is the NS_ section written to every DBC file
+var newSymbols = []string{
+	"NS_DESC_", "CM_", "BA_DEF_", "BA_", "VAL_", "CAT_DEF_", "CAT_", "FILTER", "BA_DEF_DEF_", "EV_DATA_",
+	"ENVVAR_DATA_", "SGTYPE_", "SGTYPE_VAL_", "BA_DEF_SGTYPE_", "BA_SGTYPE_", "SIG_TYPE_REF_", "VAL_TABLE_",
+	"SIG_GROUP_", "SIG_VALTYPE_", "SIGTYPE_VALTYPE_", "BO_TX_BU_", "BA_DEF_REL_", "BA_REL_", "BA_DEF_DEF_REL_",
+	"BU_SG_REL_", "BU_EV_REL_", "BU_BO_REL_", "SG_MUL_VAL_",
+}
+
+// convertDTOsToDatabase converts every DeviceProfile with a matching CANbus Device to a DBC message
+// The profiles which can't be converted are skipped and returned in the profileName-error map
+func convertDTOsToDatabase(profiles []edgexDtos.DeviceProfile, devices []edgexDtos.Device) (*descriptor.Database, *Extensions, map[string]error) {
+	db := &descriptor.Database{}
+	extensions := newExtensions()
+	validateErrors := make(map[string]error)
+	for _, profile := range profiles {
+		device, ok := findCanbusDevice(profile.Name, devices)
+		if !ok {
+			validateErrors[profile.Name] = fmt.Errorf("no device with the %s protocol uses the profile", Canbus)
+			continue
+		}
+		message, err := profileToMessage(profile, device, extensions)
+		if err != nil {
+			validateErrors[profile.Name] = err
+			continue
+		}
+		if existing, ok := db.Message(message.ID); ok {
+			validateErrors[profile.Name] = fmt.Errorf("message ID %d is already used by profile '%s'", message.ID, existing.Name)
+			continue
+		}
+		db.Messages = append(db.Messages, message)
+	}
+
+	nodes := make(map[string]struct{})
+	for _, message := range db.Messages {
+		nodes[message.SenderNode] = struct{}{}
+		for _, signal := range message.Signals {
+			for _, receiver := range signal.ReceiverNodes {
+				nodes[receiver] = struct{}{}
+			}
+		}
+	}
+	delete(nodes, defaultNode)
+	for node := range nodes {
+		db.Nodes = append(db.Nodes, &descriptor.Node{Name: node})
+	}
+	sort.Slice(db.Nodes, func(i, j int) bool { return db.Nodes[i].Name < db.Nodes[j].Name })
+	sort.Slice(db.Messages, func(i, j int) bool { return db.Messages[i].ID < db.Messages[j].ID })
+	return db, extensions, validateErrors
+}
+
+func findCanbusDevice(profileName string, devices []edgexDtos.Device) (edgexDtos.Device, bool) {
+	for _, device := range devices {
+		if _, ok := device.Protocols[Canbus]; ok && device.ProfileName == profileName {
+			return device, true
+		}
+	}
+	return edgexDtos.Device{}, false
+}
+
+// profileToMessage converts the DeviceProfile to a DBC message, with the message ID, size and sender from the
+// CANbus protocol of the Device and one signal per DeviceResource
+func profileToMessage(profile edgexDtos.DeviceProfile, device edgexDtos.Device, extensions *Extensions) (*descriptor.Message, error) {
+	if err := dbc.Identifier(profile.Name).Validate(); err != nil {
+		return nil, fmt.Errorf("invalid message name: %w", err)
+	}
+	protocol := device.Protocols[Canbus]
+	id, err := strconv.ParseUint(fmt.Sprint(protocol[ID]), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s protocol property %s: %w", Canbus, ID, err)
+	}
+	size, err := strconv.ParseUint(fmt.Sprint(protocol[DataSize]), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s protocol property %s: %w", Canbus, DataSize, err)
+	}
+	messageID := dbc.MessageID(id)
+	if protocol[Standard] == J1939 {
+		// J1939 uses extended CAN IDs
+		messageID |= messageIDExtendedFlag
+	}
+	if err = messageID.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s protocol property %s: %w", Canbus, ID, err)
+	}
+
+	message := &descriptor.Message{
+		Name:        profile.Name,
+		ID:          messageID.ToCAN(),
+		IsExtended:  messageID.IsExtended(),
+		Length:      uint8(size),
+		Description: profile.Description,
+		SenderNode:  cast.ToString(protocol[Sender]),
+	}
+	if message.SenderNode == "" {
+		message.SenderNode = defaultNode
+	}
+	if err = dbc.Identifier(message.SenderNode).Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sender node: %w", err)
+	}
+	if len(device.AutoEvents) > 0 {
+		// the AutoEvents of the message signals have the same interval
+		if message.CycleTime, err = time.ParseDuration(device.AutoEvents[0].Interval); err != nil {
+			return nil, fmt.Errorf("invalid AutoEvent interval: %w", err)
+		}
+		message.SendType = descriptor.SendTypeCyclic
+		if device.AutoEvents[0].OnChange {
+			message.SendType = descriptor.SendTypeEvent
+		}
+	}
+	if isFD, _ := cast.ToBoolE(protocol[FD]); isFD {
+		extensions.message(message).FrameFormat = frameFormats[frameFormatIndex(message)]
+	}
+
+	for _, resource := range profile.DeviceResources {
+		signal, err := resourceToSignal(resource, extensions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert device resource '%s': %w", resource.Name, err)
+		}
+		signal.ValueDescriptions, err = valueDescriptions(resource.Name, profile.DeviceCommands)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the mappings of device resource '%s': %w", resource.Name, err)
+		}
+		message.Signals = append(message.Signals, signal)
+	}
+	return message, nil
+}
+
+// resourceToSignal converts the DeviceResource to a DBC signal from the bitStart, bitLen, littleEndian, isSigned,
+// isFloat, muxSignal, muxNum, muxSwitch, muxRanges and receiverNames attributes
+func resourceToSignal(resource edgexDtos.DeviceResource, extensions *Extensions) (*descriptor.Signal, error) {
+	if err := dbc.Identifier(resource.Name).Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signal name: %w", err)
+	}
+	attributes := resource.Attributes
+	start, err := cast.ToUint16E(attributes[BitStart])
+	if err != nil || attributes[BitStart] == nil {
+		return nil, fmt.Errorf("invalid %s attribute '%v'", BitStart, attributes[BitStart])
+	}
+	length, err := cast.ToUint8E(attributes[BitLen])
+	if err != nil || length == 0 {
+		return nil, fmt.Errorf("invalid %s attribute '%v'", BitLen, attributes[BitLen])
+	}
+
+	signal := &descriptor.Signal{
+		Name:        resource.Name,
+		Start:       uint8(start), // #nosec G115
+		Length:      length,
+		Scale:       1,
+		Unit:        resource.Properties.Units,
+		Description: resource.Description,
+	}
+	littleEndian := true
+	if value, ok := attributes[LittleEndian]; ok {
+		if littleEndian, err = cast.ToBoolE(value); err != nil {
+			return nil, fmt.Errorf("invalid %s attribute: %w", LittleEndian, err)
+		}
+	}
+	signal.IsBigEndian = !littleEndian
+	if signal.IsSigned, err = cast.ToBoolE(attributes[IsSigned]); err != nil {
+		return nil, fmt.Errorf("invalid %s attribute: %w", IsSigned, err)
+	}
+	if signal.IsMultiplexer, err = cast.ToBoolE(attributes[MuxSignal]); err != nil {
+		return nil, fmt.Errorf("invalid %s attribute: %w", MuxSignal, err)
+	}
+	if muxNum, ok := attributes[MuxNum]; ok {
+		signal.IsMultiplexed = true
+		if signal.MultiplexerValue, err = cast.ToUintE(muxNum); err != nil {
+			return nil, fmt.Errorf("invalid %s attribute: %w", MuxNum, err)
+		}
+	}
+	if start > uint16(signal.Start) {
+		extensions.signal(signal).StartBit = start
+	}
+	if signal.IsFloat, err = cast.ToBoolE(attributes[IsFloat]); err != nil {
+		return nil, fmt.Errorf("invalid %s attribute: %w", IsFloat, err)
+	}
+	if signal.IsFloat {
+		switch length {
+		case 32:
+			extensions.signal(signal).ValueType = dbc.SignalValueTypeFloat32
+		case 64:
+			extensions.signal(signal).ValueType = dbc.SignalValueTypeFloat64
+		default:
+			return nil, fmt.Errorf("invalid %s attribute of the %d-bit signal", IsFloat, length)
+		}
+	}
+	if muxRanges, ok := attributes[MuxRanges]; ok {
+		if err = setMultiplexing(signal, attributes[MuxSwitch], muxRanges, extensions); err != nil {
+			return nil, err
+		}
+	}
+	if receivers, ok := attributes[ReceiverNames]; ok {
+		if signal.ReceiverNodes, err = cast.ToStringSliceE(receivers); err != nil {
+			return nil, fmt.Errorf("invalid %s attribute: %w", ReceiverNames, err)
+		}
+		for _, receiver := range signal.ReceiverNodes {
+			if err = dbc.Identifier(receiver).Validate(); err != nil {
+				return nil, fmt.Errorf("invalid receiver node: %w", err)
+			}
+		}
+	}
+
+	properties := resource.Properties
+	if properties.Scale != nil {
+		signal.Scale = *properties.Scale
+	}
+	if properties.Offset != nil {
+		signal.Offset = *properties.Offset
+	}
+	if properties.Minimum != nil {
+		signal.Min = *properties.Minimum
+	}
+	if properties.Maximum != nil {
+		signal.Max = *properties.Maximum
+	}
+	if properties.DefaultValue != "" {
+		if signal.DefaultValue, err = strconv.Atoi(properties.DefaultValue); err != nil {
+			return nil, fmt.Errorf("invalid default value: %w", err)
+		}
+	}
+	return signal, nil
+}
+
+// setMultiplexing sets the extended multiplexing of the signal from the muxSwitch and muxRanges attributes
+func setMultiplexing(signal *descriptor.Signal, muxSwitch, muxRanges any, extensions *Extensions) error {
+	if !signal.IsMultiplexed {
+		return fmt.Errorf("the %s attribute requires the %s attribute", MuxRanges, MuxNum)
+	}
+	name, err := cast.ToStringE(muxSwitch)
+	if err == nil {
+		err = dbc.Identifier(name).Validate()
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s attribute: %w", MuxSwitch, err)
+	}
+	ranges, err := cast.ToStringSliceE(muxRanges)
+	if err != nil {
+		return fmt.Errorf("invalid %s attribute: %w", MuxRanges, err)
+	}
+	extension := extensions.signal(signal)
+	extension.MultiplexerSwitch = name
+	for _, r := range ranges {
+		muxRange, err := parseMultiplexerRange(r)
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute: %w", MuxRanges, err)
+		}
+		extension.MultiplexerRanges = append(extension.MultiplexerRanges, muxRange)
+	}
+	return nil
+}
+
+// frameFormatIndex returns the VFrameFormat enum index of the CAN FD message
+func frameFormatIndex(message *descriptor.Message) int {
+	if message.IsExtended {
+		return 15
+	}
+	return 14
+}
+
+// valueDescriptions returns the value descriptions of the signal from the mappings of the resource operations
+// of the DeviceCommands which operate the DeviceResource
+func valueDescriptions(resourceName string, deviceCommands []edgexDtos.DeviceCommand) ([]*descriptor.ValueDescription, error) {
+	mappings := make(map[int64]string)
+	for _, deviceCommand := range deviceCommands {
+		for _, resourceOperation := range deviceCommand.ResourceOperations {
+			if resourceOperation.DeviceResource != resourceName {
+				continue
+			}
+			for raw, description := range resourceOperation.Mappings {
+				value, err := strconv.ParseInt(raw, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid mapping value '%s': %w", raw, err)
+				}
+				mappings[value] = description
+			}
+		}
+	}
+	result := make([]*descriptor.ValueDescription, 0, len(mappings))
+	for value, description := range mappings {
+		result = append(result, &descriptor.ValueDescription{Value: value, Description: description})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Value < result[j].Value })
+	return result, nil
+}
+
+// formatDBC writes the DBC database and its extensions in the DBC file format
+func formatDBC(db *descriptor.Database, extensions *Extensions) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "VERSION %s\n\n\n", quote(db.Version))
+	buf.WriteString("NS_ : \n")
+	for _, symbol := range newSymbols {
+		fmt.Fprintf(&buf, "\t%s\n", symbol)
+	}
+	buf.WriteString("\nBS_:\n\nBU_:")
+	for _, node := range db.Nodes {
+		fmt.Fprintf(&buf, " %s", node.Name)
+	}
+	buf.WriteString("\n\n")
+
+	for _, message := range db.Messages {
+		fmt.Fprintf(&buf, "\nBO_ %d %s: %d %s\n", messageID(message), message.Name, message.Length, message.SenderNode)
+		for _, signal := range message.Signals {
+			formatSignal(&buf, signal, extensions.StartBit(signal))
+		}
+	}
+	buf.WriteString("\n\n")
+
+	for _, node := range db.Nodes {
+		if node.Description != "" {
+			fmt.Fprintf(&buf, "CM_ BU_ %s %s;\n", node.Name, quote(node.Description))
+		}
+	}
+	for _, message := range db.Messages {
+		if message.Description != "" {
+			fmt.Fprintf(&buf, "CM_ BO_ %d %s;\n", messageID(message), quote(message.Description))
+		}
+		for _, signal := range message.Signals {
+			if signal.Description != "" {
+				fmt.Fprintf(&buf, "CM_ SG_ %d %s %s;\n", messageID(message), signal.Name, quote(signal.Description))
+			}
+		}
+	}
+
+	formats := make([]string, len(frameFormats))
+	for i, format := range frameFormats {
+		formats[i] = quote(format)
+	}
+	attributes := []*dbcAttribute{
+		{name: attributeVFrameFormat, objectType: "BO_", valueType: "ENUM  " + strings.Join(formats, ","), defaultValue: quote(frameFormats[0])},
+		{name: attributeGenMsgSendType, objectType: "BO_", valueType: `ENUM  "Cyclic","Event"`, defaultValue: quote("Cyclic")},
+		{name: attributeGenMsgCycleTime, objectType: "BO_", valueType: "INT 0 2147483647", defaultValue: "0"},
+		{name: attributeGenSigStartValue, objectType: "SG_", valueType: "INT -2147483648 2147483647", defaultValue: "0"},
+	}
+	frameFormat, sendType, cycleTime, startValue := attributes[0], attributes[1], attributes[2], attributes[3]
+	for _, message := range db.Messages {
+		if extensions.IsFD(message) {
+			frameFormat.addValue("BO_ %d %d", messageID(message), frameFormatIndex(message))
+		}
+		if message.SendType == descriptor.SendTypeEvent {
+			sendType.addValue("BO_ %d 1", messageID(message))
+		}
+		if message.CycleTime > 0 {
+			cycleTime.addValue("BO_ %d %d", messageID(message), message.CycleTime.Milliseconds())
+		}
+		for _, signal := range message.Signals {
+			if signal.DefaultValue != 0 {
+				startValue.addValue("SG_ %d %s %d", messageID(message), signal.Name, signal.DefaultValue)
+			}
+		}
+	}
+	formatAttributes(&buf, attributes)
+
+	buf.WriteString("\n")
+	for _, message := range db.Messages {
+		for _, signal := range message.Signals {
+			if len(signal.ValueDescriptions) == 0 {
+				continue
+			}
+			fmt.Fprintf(&buf, "VAL_ %d %s", messageID(message), signal.Name)
+			for _, valueDescription := range signal.ValueDescriptions {
+				fmt.Fprintf(&buf, " %d %s", valueDescription.Value, quote(valueDescription.Description))
+			}
+			buf.WriteString(" ;\n")
+		}
+	}
+
+	for _, message := range db.Messages {
+		for _, signal := range message.Signals {
+			if signal.IsFloat {
+				fmt.Fprintf(&buf, "SIG_VALTYPE_ %d %s : %d;\n", messageID(message), signal.Name, extensions.Signals[signal].ValueType)
+			}
+		}
+	}
+	for _, message := range db.Messages {
+		for _, signal := range message.Signals {
+			muxSwitch, muxRanges := extensions.Multiplexing(signal)
+			if len(muxRanges) == 0 {
+				continue
+			}
+			ranges := make([]string, len(muxRanges))
+			for i, muxRange := range muxRanges {
+				ranges[i] = muxRange.String()
+			}
+			fmt.Fprintf(&buf, "%s %d %s %s %s;\n", keywordSignalMultiplexValue, messageID(message), signal.Name, muxSwitch, strings.Join(ranges, ", "))
+		}
+	}
+	return buf.Bytes()
+}
+
+// dbcAttribute is a DBC attribute definition with the BA_ values of the objects
+type dbcAttribute struct {
+	name         string
+	objectType   string
+	valueType    string
+	defaultValue string
+	values       []string
+}
+
+func (a *dbcAttribute) addValue(format string, args ...any) {
+	a.values = append(a.values, fmt.Sprintf("BA_ %s %s;\n", quote(a.name), fmt.Sprintf(format, args...)))
+}
+
+// formatAttributes writes the BA_DEF_, BA_DEF_DEF_ and BA_ definitions of the attributes which have values
+func formatAttributes(buf *bytes.Buffer, attributes []*dbcAttribute) {
+	var used []*dbcAttribute
+	for _, attribute := range attributes {
+		if len(attribute.values) > 0 {
+			used = append(used, attribute)
+		}
+	}
+	if len(used) == 0 {
+		return
+	}
+	buf.WriteString("\n")
+	for _, attribute := range used {
+		fmt.Fprintf(buf, "BA_DEF_ %s  %s %s;\n", attribute.objectType, quote(attribute.name), attribute.valueType)
+	}
+	for _, attribute := range used {
+		fmt.Fprintf(buf, "BA_DEF_DEF_  %s %s;\n", quote(attribute.name), attribute.defaultValue)
+	}
+	for _, attribute := range used {
+		for _, value := range attribute.values {
+			buf.WriteString(value)
+		}
+	}
+}
+
+// formatSignal writes the SG_ line of the signal, e.g. SG_ Speed m1 : 8|16@1+ (0.1,0) [0|6553.5] "km/h" ECU
+func formatSignal(buf *bytes.Buffer, signal *descriptor.Signal, start uint16) {
+	fmt.Fprintf(buf, " SG_ %s ", signal.Name)
+	switch {
+	case signal.IsMultiplexed && signal.IsMultiplexer:
+		fmt.Fprintf(buf, "m%dM ", signal.MultiplexerValue)
+	case signal.IsMultiplexed:
+		fmt.Fprintf(buf, "m%d ", signal.MultiplexerValue)
+	case signal.IsMultiplexer:
+		buf.WriteString("M ")
+	}
+	byteOrder := 1
+	if signal.IsBigEndian {
+		byteOrder = 0
+	}
+	sign := "+"
+	if signal.IsSigned {
+		sign = "-"
+	}
+	receivers := signal.ReceiverNodes
+	if len(receivers) == 0 {
+		receivers = []string{defaultNode}
+	}
+	fmt.Fprintf(buf, ": %d|%d@%d%s (%s,%s) [%s|%s] %s %s\n",
+		start, signal.Length, byteOrder, sign,
+		formatFloat(signal.Scale), formatFloat(signal.Offset), formatFloat(signal.Min), formatFloat(signal.Max),
+		quote(signal.Unit), strings.Join(receivers, ","))
+}
+
+// messageID returns the DBC message ID, which has the most significant bit set for extended CAN IDs
+func messageID(message *descriptor.Message) uint32 {
+	if message.IsExtended {
+		return message.ID | messageIDExtendedFlag
+	}
+	return message.ID
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// quote returns the DBC string of s, escaping the unescaped double quotes
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' && (i == 0 || s[i-1] != '\\') {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package dbc
+
+import (
+	"os"
+	"testing"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func convertDBC(t *testing.T, data []byte) ([]edgexDtos.DeviceProfile, []edgexDtos.Device) {
+	profileConverter, err := ConvertDBCtoProfile(data)
+	require.NoError(t, err)
+	require.Empty(t, profileConverter.GetValidateErrors())
+	deviceConverter, err := ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can", Network: "can0"})
+	require.NoError(t, err)
+	require.Empty(t, deviceConverter.GetValidateErrors())
+	return profileConverter.GetDTOs(), deviceConverter.GetDTOs()
+}
+
+func TestConvertProfilesToDBC_RoundTrip(t *testing.T) {
+	sample, err := os.ReadFile("dbc_sample.dbc")
+	require.NoError(t, err)
+
+	multiplexed := []byte(`VERSION "1.0"
+
+BU_: ECU Dashboard Gateway
+
+BO_ 2364539902 EEC2: 8 Vector__XXX
+ SG_ Accelerator_Pedal_1_Low_Idle_Swi : 0|2@1+ (1,0) [0|3] "bit" Vector__XXX
+
+BO_ 291 Status: 64 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|255] "" Dashboard
+ SG_ Speed m1 : 15|16@0- (0.1,-40) [-40|6513.5] "km/h" Dashboard,Gateway
+ SG_ Gear m2 : 16|4@1+ (1,0) [0|15] "" Dashboard
+
+CM_ BO_ 291 "Status with \"quoted\" text";
+CM_ SG_ 291 Speed "Vehicle speed";
+BA_DEF_ SG_  "GenSigStartValue" INT -2147483648 2147483647;
+BA_DEF_DEF_  "GenSigStartValue" 0;
+BA_ "GenSigStartValue" SG_ 291 Gear 3;
+VAL_ 291 Gear 0 "Neutral" 1 "Drive" 15 "Reverse" ;
+`)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"sample", sample},
+		{"multiplexed, big endian, signed and default values", multiplexed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles, devices := convertDBC(t, tt.data)
+
+			data, validateErrors, err := ConvertProfilesToDBC(profiles, devices)
+			require.NoError(t, err)
+			require.Empty(t, validateErrors)
+
+			resultProfiles, resultDevices := convertDBC(t, data)
+			assert.Equal(t, profiles, resultProfiles)
+			assert.Equal(t, devices, resultDevices)
+		})
+	}
+}
+
+func TestConvertProfilesToDBC_Format(t *testing.T) {
+	scale := 0.5
+	profiles := []edgexDtos.DeviceProfile{{
+		DeviceProfileBasicInfo: edgexDtos.DeviceProfileBasicInfo{Name: "Engine"},
+		DeviceResources: []edgexDtos.DeviceResource{{
+			Name:       "Rpm",
+			Properties: edgexDtos.ResourceProperties{ValueType: edgexCommon.ValueTypeFloat64, Units: "rpm", Scale: &scale},
+			Attributes: map[string]any{BitStart: "8", BitLen: 16, ReceiverNames: []any{"Dashboard"}},
+		}},
+		DeviceCommands: []edgexDtos.DeviceCommand{{
+			Name:               "Rpm",
+			ResourceOperations: []edgexDtos.ResourceOperation{{DeviceResource: "Rpm", Mappings: map[string]string{"10": "High", "0": "Off"}}},
+		}},
+	}}
+	devices := []edgexDtos.Device{{
+		Name:        "engine-1",
+		ProfileName: "Engine",
+		Protocols:   map[string]edgexDtos.ProtocolProperties{Canbus: {ID: "256", DataSize: "8", Sender: "ECU"}},
+	}}
+
+	data, validateErrors, err := ConvertProfilesToDBC(profiles, devices)
+	require.NoError(t, err)
+	require.Empty(t, validateErrors)
+	assert.Contains(t, string(data), "BU_: Dashboard ECU\n")
+	assert.Contains(t, string(data), "BO_ 256 Engine: 8 ECU\n")
+	assert.Contains(t, string(data), ` SG_ Rpm : 8|16@1+ (0.5,0) [0|0] "rpm" Dashboard`)
+	assert.Contains(t, string(data), `VAL_ 256 Rpm 0 "Off" 10 "High" ;`)
+
+	result, compileErr := Compile("", data)
+	require.NoError(t, compileErr)
+	require.Len(t, result.Database.Messages, 1)
+	assert.False(t, result.Database.Messages[0].IsExtended)
+}
+
+func TestConvertProfilesToDBC_ValidateErrors(t *testing.T) {
+	profile := func(name string, attributes map[string]any) edgexDtos.DeviceProfile {
+		return edgexDtos.DeviceProfile{
+			DeviceProfileBasicInfo: edgexDtos.DeviceProfileBasicInfo{Name: name},
+			DeviceResources:        []edgexDtos.DeviceResource{{Name: "Signal", Attributes: attributes}},
+		}
+	}
+	device := func(profileName, id string) edgexDtos.Device {
+		return edgexDtos.Device{
+			Name:        profileName,
+			ProfileName: profileName,
+			Protocols:   map[string]edgexDtos.ProtocolProperties{Canbus: {ID: id, DataSize: "8"}},
+		}
+	}
+	validAttributes := map[string]any{BitStart: 0, BitLen: 8}
+
+	profiles := []edgexDtos.DeviceProfile{
+		profile("Valid", validAttributes),
+		profile("NoDevice", validAttributes),
+		profile("DuplicateID", validAttributes),
+		profile("InvalidID", validAttributes),
+		profile("MissingBitStart", map[string]any{BitLen: 8}),
+		profile("InvalidBitLen", map[string]any{BitStart: 0, BitLen: "x"}),
+		profile("Invalid Name", validAttributes),
+	}
+	devices := []edgexDtos.Device{
+		device("Valid", "1"),
+		device("DuplicateID", "1"),
+		device("InvalidID", "id"),
+		device("MissingBitStart", "2"),
+		device("InvalidBitLen", "3"),
+		device("Invalid Name", "4"),
+	}
+
+	data, validateErrors, err := ConvertProfilesToDBC(profiles, devices)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "BO_ 1 Valid: 8 Vector__XXX")
+	require.Len(t, validateErrors, len(profiles)-1)
+	for _, p := range profiles[1:] {
+		assert.Error(t, validateErrors[p.Name], p.Name)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `""`, quote(""))
+	assert.Equal(t, `"a \"b\""`, quote(`a "b"`))
+	assert.Equal(t, `"a \"b\""`, quote(`a \"b\"`))
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+const (
+	ServiceName = "ServiceName"
+	ProfileName = "ProfileName"
+
+	Sparkplug  = "Sparkplug"
+	GroupId    = "GroupId"
+	EdgeNodeId = "EdgeNodeId"
+	DeviceId   = "DeviceId"
+
+	MetricName = "metricName"
+	Alias      = "alias"
+	DataType   = "dataType"
+)
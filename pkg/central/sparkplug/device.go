@@ -0,0 +1,66 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	sparkplugB "github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// deviceDBIRTH stores the DBIRTH topic, conversion arguments, and the converted Device DTO
+type deviceDBIRTH struct {
+	topic          sparkplugB.Topic
+	args           map[string]string
+	device         edgexDtos.Device
+	validateErrors map[string]error
+}
+
+func newDeviceDBIRTH(topic string, data []byte, args map[string]string) (Converter[edgexDtos.Device], errors.EdgeX) {
+	birthTopic, _, err := parseDBIRTH(topic, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deviceDBIRTH{
+		topic:          birthTopic,
+		args:           args,
+		validateErrors: make(map[string]error),
+	}, nil
+}
+
+// ConvertToDTO converts the DBIRTH topic to the Device DTO of the Sparkplug device
+func (dBirth *deviceDBIRTH) ConvertToDTO() errors.EdgeX {
+	deviceDTO := edgexDtos.Device{
+		Name:           dBirth.topic.DeviceId,
+		AdminState:     edgexModels.Unlocked,
+		OperatingState: edgexModels.Up,
+		ProfileName:    profileName(dBirth.topic, dBirth.args),
+		ServiceName:    dBirth.args[ServiceName],
+		Protocols: map[string]edgexDtos.ProtocolProperties{
+			Sparkplug: {
+				GroupId:    dBirth.topic.GroupId,
+				EdgeNodeId: dBirth.topic.EdgeNodeId,
+				DeviceId:   dBirth.topic.DeviceId,
+			},
+		},
+	}
+
+	if validateErr := edgexCommon.Validate(deviceDTO); validateErr != nil {
+		dBirth.validateErrors[deviceDTO.Name] = validateErr
+	} else {
+		dBirth.device = deviceDTO
+	}
+	return nil
+}
+
+func (dBirth *deviceDBIRTH) GetDTOs() edgexDtos.Device {
+	return dBirth.device
+}
+
+func (dBirth *deviceDBIRTH) GetValidateErrors() map[string]error {
+	return dBirth.validateErrors
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"fmt"
+
+	sparkplugB "github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/spf13/cast"
+)
+
+// deviceProfileDBIRTH stores the DBIRTH topic and payload, conversion arguments, and the converted DeviceProfile DTO
+type deviceProfileDBIRTH struct {
+	topic          sparkplugB.Topic
+	payload        *protobuf.Payload
+	args           map[string]string
+	deviceProfile  edgexDtos.DeviceProfile
+	validateErrors map[string]error
+}
+
+func newDeviceProfileDBIRTH(topic string, data []byte, args map[string]string) (Converter[edgexDtos.DeviceProfile], errors.EdgeX) {
+	birthTopic, payload, err := parseDBIRTH(topic, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deviceProfileDBIRTH{
+		topic:          birthTopic,
+		payload:        payload,
+		args:           args,
+		validateErrors: make(map[string]error, len(payload.GetMetrics())),
+	}, nil
+}
+
+// ConvertToDTO parses the DBIRTH metrics and converts them to the DeviceProfile DTO with one DeviceResource per metric
+func (dpBirth *deviceProfileDBIRTH) ConvertToDTO() errors.EdgeX {
+	var profileDto edgexDtos.DeviceProfile
+	profileDto.Name = profileName(dpBirth.topic, dpBirth.args)
+
+	for i, metric := range dpBirth.payload.GetMetrics() {
+		deviceResource, err := metricToDeviceResource(metric)
+		if err != nil {
+			key := metric.GetName()
+			if key == "" {
+				key = fmt.Sprintf("metric %d", i)
+			}
+			dpBirth.validateErrors[key] = err
+			continue
+		}
+		if validateErr := edgexCommon.Validate(deviceResource); validateErr != nil {
+			dpBirth.validateErrors[deviceResource.Name] = validateErr
+			continue
+		}
+		profileDto.DeviceResources = append(profileDto.DeviceResources, deviceResource)
+	}
+
+	if validateErr := edgexCommon.Validate(profileDto); validateErr != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device profile '%s'", profileDto.Name), validateErr)
+	}
+	dpBirth.deviceProfile = profileDto
+	return nil
+}
+
+func (dpBirth *deviceProfileDBIRTH) GetDTOs() edgexDtos.DeviceProfile {
+	return dpBirth.deviceProfile
+}
+
+func (dpBirth *deviceProfileDBIRTH) GetValidateErrors() map[string]error {
+	return dpBirth.validateErrors
+}
+
+// metricToDeviceResource converts the DBIRTH metric to a DeviceResource
+// The units, range and description come from the metric properties engUnit, engLow, engHigh and description,
+// and the resource is writable when the readOnly property is false or the writable property is true
+func metricToDeviceResource(metric *protobuf.Payload_Metric) (edgexDtos.DeviceResource, error) {
+	if metric.GetName() == "" {
+		return edgexDtos.DeviceResource{}, fmt.Errorf("metric name is required")
+	}
+	dataType := protobuf.DataType(metric.GetDatatype())
+	valueType, err := sparkplugB.ToEdgeXValueType(dataType)
+	if err != nil {
+		return edgexDtos.DeviceResource{}, err
+	}
+
+	deviceResource := edgexDtos.DeviceResource{
+		Name: metric.GetName(),
+		Properties: edgexDtos.ResourceProperties{
+			ValueType: valueType,
+			ReadWrite: edgexCommon.ReadWrite_R,
+		},
+		Attributes: map[string]any{
+			MetricName: metric.GetName(),
+			DataType:   dataType.String(),
+		},
+	}
+	if metric.Alias != nil {
+		deviceResource.Attributes[Alias] = metric.GetAlias()
+	}
+	if valueType == edgexCommon.ValueTypeBinary {
+		deviceResource.Properties.MediaType = metric.GetMetadata().GetContentType()
+	}
+
+	properties := metric.GetProperties()
+	if engUnit, ok := sparkplugB.LookupProperty(properties, sparkplugB.PropertyEngUnit); ok && engUnit != nil {
+		if deviceResource.Properties.Units, err = cast.ToStringE(engUnit); err != nil {
+			return edgexDtos.DeviceResource{}, fmt.Errorf("invalid %s property: %w", sparkplugB.PropertyEngUnit, err)
+		}
+	}
+	if description, ok := sparkplugB.LookupProperty(properties, sparkplugB.PropertyDescription); ok && description != nil {
+		deviceResource.Description = cast.ToString(description)
+	}
+	if deviceResource.Properties.Minimum, err = floatProperty(properties, sparkplugB.PropertyEngLow); err != nil {
+		return edgexDtos.DeviceResource{}, err
+	}
+	if deviceResource.Properties.Maximum, err = floatProperty(properties, sparkplugB.PropertyEngHigh); err != nil {
+		return edgexDtos.DeviceResource{}, err
+	}
+
+	readOnly, hasReadOnly := sparkplugB.LookupProperty(properties, sparkplugB.PropertyReadOnly)
+	writable, hasWritable := sparkplugB.LookupProperty(properties, sparkplugB.PropertyWritable)
+	if (hasReadOnly && readOnly == false) || (hasWritable && writable == true) {
+		deviceResource.Properties.ReadWrite = edgexCommon.ReadWrite_RW
+	}
+
+	return deviceResource, nil
+}
+
+func floatProperty(properties *protobuf.Payload_PropertySet, key string) (*float64, error) {
+	value, ok := sparkplugB.LookupProperty(properties, key)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	f, err := cast.ToFloat64E(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s property: %w", key, err)
+	}
+	return &f, nil
+}
@@ -0,0 +1,21 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+type AllowedDTOTypes interface {
+	edgexDtos.DeviceProfile | edgexDtos.Device
+}
+
+type Converter[T AllowedDTOTypes] interface {
+	// ConvertToDTO parses the DBIRTH payload to DTOs
+	ConvertToDTO() errors.EdgeX
+	// GetDTOs returns the converted DTOs
+	GetDTOs() T
+	// GetValidateErrors returns the metricName-validationError key-value map while parsing the DBIRTH metrics to DTOs
+	GetValidateErrors() map[string]error
+}
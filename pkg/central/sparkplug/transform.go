@@ -0,0 +1,69 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"fmt"
+
+	sparkplugB "github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ConvertDBIRTHtoProfile converts the DBIRTH payload received on the topic to a DeviceProfile DTO
+// The profile is named after the device id of the topic unless the ProfileName argument is set
+func ConvertDBIRTHtoProfile(topic string, data []byte, args map[string]string) (Converter[edgexDtos.DeviceProfile], errors.EdgeX) {
+	converter, err := newDeviceProfileDBIRTH(topic, data, args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = converter.ConvertToDTO()
+	if err != nil {
+		return nil, err
+	}
+
+	return converter, nil
+}
+
+// ConvertDBIRTHtoDevice converts the DBIRTH payload received on the topic to a Device DTO
+func ConvertDBIRTHtoDevice(topic string, data []byte, args map[string]string) (Converter[edgexDtos.Device], errors.EdgeX) {
+	converter, err := newDeviceDBIRTH(topic, data, args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = converter.ConvertToDTO()
+	if err != nil {
+		return nil, err
+	}
+
+	return converter, nil
+}
+
+func parseDBIRTH(topic string, data []byte) (sparkplugB.Topic, *protobuf.Payload, errors.EdgeX) {
+	birthTopic, err := sparkplugB.ParseTopic(topic)
+	if err != nil {
+		return sparkplugB.Topic{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse Sparkplug topic", err)
+	}
+	if birthTopic.MessageType != sparkplugB.MessageTypeDBIRTH {
+		return sparkplugB.Topic{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("topic '%s' is not a DBIRTH topic", topic), nil)
+	}
+
+	var payload protobuf.Payload
+	if err = proto.Unmarshal(data, &payload); err != nil {
+		return sparkplugB.Topic{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode Sparkplug payload", err)
+	}
+	return birthTopic, &payload, nil
+}
+
+func profileName(topic sparkplugB.Topic, args map[string]string) string {
+	if name := args[ProfileName]; name != "" {
+		return name
+	}
+	return topic.DeviceId
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testTopic = "spBv1.0/plant/DBIRTH/gateway/boiler"
+
+func newTestPropertySet(keys []string, values ...*protobuf.Payload_PropertyValue) *protobuf.Payload_PropertySet {
+	return &protobuf.Payload_PropertySet{Keys: keys, Values: values}
+}
+
+func newTestDBIRTH(t *testing.T) []byte {
+	payload := &protobuf.Payload{
+		Seq: proto.Uint64(1),
+		Metrics: []*protobuf.Payload_Metric{
+			{
+				Name:     proto.String("temperature"),
+				Alias:    proto.Uint64(1),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Double)),
+				Properties: newTestPropertySet(
+					[]string{"engUnit", "engLow", "engHigh", "description"},
+					&protobuf.Payload_PropertyValue{Type: proto.Uint32(uint32(protobuf.DataType_String)), Value: &protobuf.Payload_PropertyValue_StringValue{StringValue: "degC"}},
+					&protobuf.Payload_PropertyValue{Type: proto.Uint32(uint32(protobuf.DataType_Int32)), Value: &protobuf.Payload_PropertyValue_IntValue{IntValue: uint32(0xFFFFFFF6)}},
+					&protobuf.Payload_PropertyValue{Type: proto.Uint32(uint32(protobuf.DataType_Float)), Value: &protobuf.Payload_PropertyValue_FloatValue{FloatValue: 120}},
+					&protobuf.Payload_PropertyValue{Type: proto.Uint32(uint32(protobuf.DataType_String)), Value: &protobuf.Payload_PropertyValue_StringValue{StringValue: "water temperature"}},
+				),
+				Value: &protobuf.Payload_Metric_DoubleValue{DoubleValue: 21.5},
+			},
+			{
+				Name:     proto.String("setpoint"),
+				Alias:    proto.Uint64(2),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Int32)),
+				Properties: newTestPropertySet(
+					[]string{"readOnly"},
+					&protobuf.Payload_PropertyValue{Type: proto.Uint32(uint32(protobuf.DataType_Boolean)), Value: &protobuf.Payload_PropertyValue_BooleanValue{BooleanValue: false}},
+				),
+				Value: &protobuf.Payload_Metric_IntValue{IntValue: 60},
+			},
+			{
+				Name:     proto.String("snapshot"),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Bytes)),
+				Metadata: &protobuf.Payload_MetaData{ContentType: proto.String("image/png")},
+				Value:    &protobuf.Payload_Metric_BytesValue{BytesValue: []byte{1}},
+			},
+			{
+				Name:     proto.String("settings"),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_PropertySet)),
+			},
+			{
+				Alias:    proto.Uint64(5),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Int32)),
+			},
+		},
+	}
+	data, err := proto.Marshal(payload)
+	require.NoError(t, err)
+	return data
+}
+
+func TestConvertDBIRTHtoProfile(t *testing.T) {
+	converter, err := ConvertDBIRTHtoProfile(testTopic, newTestDBIRTH(t), nil)
+	require.NoError(t, err)
+	profile := converter.GetDTOs()
+	assert.Equal(t, "boiler", profile.Name)
+	require.Len(t, profile.DeviceResources, 3)
+
+	minimum := float64(-10)
+	maximum := float64(120)
+	expectedTemperature := edgexDtos.DeviceResource{
+		Name:        "temperature",
+		Description: "water temperature",
+		Properties: edgexDtos.ResourceProperties{
+			ValueType: edgexCommon.ValueTypeFloat64,
+			ReadWrite: edgexCommon.ReadWrite_R,
+			Units:     "degC",
+			Minimum:   &minimum,
+			Maximum:   &maximum,
+		},
+		Attributes: map[string]any{
+			MetricName: "temperature",
+			DataType:   "Double",
+			Alias:      uint64(1),
+		},
+	}
+	assert.Equal(t, expectedTemperature, profile.DeviceResources[0])
+
+	assert.Equal(t, edgexCommon.ValueTypeInt32, profile.DeviceResources[1].Properties.ValueType)
+	assert.Equal(t, edgexCommon.ReadWrite_RW, profile.DeviceResources[1].Properties.ReadWrite)
+	assert.Equal(t, edgexCommon.ValueTypeBinary, profile.DeviceResources[2].Properties.ValueType)
+	assert.Equal(t, "image/png", profile.DeviceResources[2].Properties.MediaType)
+
+	validateErrors := converter.GetValidateErrors()
+	require.Len(t, validateErrors, 2)
+	assert.Contains(t, validateErrors, "settings")
+	assert.Contains(t, validateErrors, "metric 4")
+
+	converter, err = ConvertDBIRTHtoProfile(testTopic, newTestDBIRTH(t), map[string]string{ProfileName: "Boiler-Profile"})
+	require.NoError(t, err)
+	assert.Equal(t, "Boiler-Profile", converter.GetDTOs().Name)
+}
+
+func TestConvertDBIRTHtoDevice(t *testing.T) {
+	args := map[string]string{
+		ServiceName: "device-sparkplug",
+		ProfileName: "Boiler-Profile",
+	}
+	converter, err := ConvertDBIRTHtoDevice(testTopic, newTestDBIRTH(t), args)
+	require.NoError(t, err)
+	require.Empty(t, converter.GetValidateErrors())
+
+	expected := edgexDtos.Device{
+		Name:           "boiler",
+		AdminState:     edgexModels.Unlocked,
+		OperatingState: edgexModels.Up,
+		ProfileName:    "Boiler-Profile",
+		ServiceName:    "device-sparkplug",
+		Protocols: map[string]edgexDtos.ProtocolProperties{
+			Sparkplug: {
+				GroupId:    "plant",
+				EdgeNodeId: "gateway",
+				DeviceId:   "boiler",
+			},
+		},
+	}
+	assert.Equal(t, expected, converter.GetDTOs())
+}
+
+func TestConvertDBIRTH_Invalid(t *testing.T) {
+	_, err := ConvertDBIRTHtoProfile("spBv1.0/plant/DDATA/gateway/boiler", newTestDBIRTH(t), nil)
+	require.Error(t, err)
+	_, err = ConvertDBIRTHtoDevice("invalid", newTestDBIRTH(t), nil)
+	require.Error(t, err)
+	_, err = ConvertDBIRTHtoProfile(testTopic, []byte{0xff}, nil)
+	require.Error(t, err)
+}
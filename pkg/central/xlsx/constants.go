@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2024 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
@@ -11,6 +11,7 @@ const (
 	deviceInfoSheetName     = "DeviceInfo"
 	deviceResourceSheetName = "DeviceResource"
 	deviceCommandSheetName  = "DeviceCommand"
+	sourceNamesSheetName    = "SourceNames"
 )
 
 // constants relates to the header names
@@ -55,6 +56,14 @@ const (
 	onChange           = "OnChange"
 )
 
+// constants relates to the XRT Schedule field names
+const (
+	resource        = "Resource"
+	bounds          = "Bounds"
+	publish         = "Publish"
+	scheduleOptions = "Options"
+)
+
 // constants relates to the Device protocols
 const (
 	modbusRTU = "modbus-rtu"
@@ -0,0 +1,123 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const csvExt = ".csv"
+
+// ConvertDeviceCSV converts the CSV files of the directory to Device DTOs by the options, each CSV file defines the
+// worksheet named by the file name, e.g. MappingTable.csv, Devices.csv, AutoEvents.csv and Schedules.csv
+func ConvertDeviceCSV(fsys fs.FS, options ImportOptions) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
+	f, edgexErr := readCSVWorkbook(fsys)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return convertDeviceWorkbook(f, options)
+}
+
+// ConvertDeviceProfileCSV converts the CSV files of the directory to DeviceProfile DTO, each CSV file defines the
+// worksheet named by the file name, e.g. MappingTable.csv, DeviceInfo.csv, DeviceResource.csv and DeviceCommand.csv
+func ConvertDeviceProfileCSV(fsys fs.FS) (Converter[*edgexDtos.DeviceProfile], error) {
+	f, edgexErr := readCSVWorkbook(fsys)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+	return convertDeviceProfileWorkbook(f)
+}
+
+// readCSVArchive loads the CSV files of the zip archive to the workbook
+func readCSVArchive(file io.Reader) (*excelize.File, errors.EdgeX) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to read zip archive from io.Reader", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to open zip archive of the CSV files", err)
+	}
+	return readCSVWorkbook(archive)
+}
+
+// readCSVWorkbook loads the CSV files of the root directory to the worksheets named by the file names
+func readCSVWorkbook(fsys fs.FS) (*excelize.File, errors.EdgeX) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to read the directory of the CSV files", err)
+	}
+
+	var sheetNames []string
+	sheetRows := make(map[string][][]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(path.Ext(entry.Name()), csvExt) {
+			continue
+		}
+		rows, edgexErr := readCSVFile(fsys, entry.Name())
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		sheetName := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		sheetNames = append(sheetNames, sheetName)
+		sheetRows[sheetName] = rows
+	}
+	return newSheetsWorkbook(sheetNames, sheetRows)
+}
+
+func readCSVFile(fsys fs.FS, name string) ([][]string, errors.EdgeX) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to open CSV file %s", name), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	// the rows may define different numbers of cells, e.g. the extra device names of the AutoEvents rows
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse CSV file %s", name), err)
+	}
+	// remove the byte order mark written by the spreadsheet applications
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+	}
+	return rows, nil
+}
+
+// writeCSVArchive writes the worksheets of the workbook to the zip archive of the CSV files named by the worksheets
+func writeCSVArchive(f *excelize.File, w io.Writer) errors.EdgeX {
+	archive := zip.NewWriter(w)
+	for _, sheetName := range f.GetSheetList() {
+		rows, err := f.GetRows(sheetName)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet", sheetName), err)
+		}
+		file, err := archive.Create(sheetName + csvExt)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create CSV file of %s worksheet", sheetName), err)
+		}
+		err = csv.NewWriter(file).WriteAll(rows)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to write CSV file of %s worksheet", sheetName), err)
+		}
+	}
+	err := archive.Close()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write zip archive to io.Writer", err)
+	}
+	return nil
+}
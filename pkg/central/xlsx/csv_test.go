@@ -0,0 +1,48 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDeviceCSV(t *testing.T) {
+	fsys := fstest.MapFS{
+		// the byte order mark of the first cell should be removed
+		"MappingTable.csv": {Data: []byte("\ufeffObject,Path,Default Value\n" +
+			"AdminState,adminState,UNLOCKED\n" +
+			"OperatingState,operatingState,UP\n" +
+			"ProtocolName,properties.IOTech_ProtocolName,modbus-rtu\n" +
+			"Interval,autoEvents[].interval,1s\n" +
+			"Address,protocols.modbus-rtu.Address,\n")},
+		"Devices.csv": {Data: []byte("Name,ServiceName,ProfileName,Address\n" +
+			"Sensor01,device-modbus,rtu-profile,/dev/virtualport\n" +
+			"Sensor02,device-modbus,rtu-profile,/dev/virtualport\n")},
+		"AutoEvents.csv": {Data: []byte("SourceName,Reference Device Name\n" +
+			"temperature,Sensor01,Sensor02\n")},
+		"README.txt":      {Data: []byte("not a worksheet")},
+		"archive/old.csv": {Data: []byte("Name\n")},
+	}
+
+	conv, edgexErr := ConvertDeviceCSV(fsys, ImportOptions{})
+	require.NoError(t, edgexErr)
+	devices := conv.GetDTOs()
+	require.Len(t, devices, 2)
+	for _, device := range devices {
+		require.Equal(t, "UNLOCKED", device.AdminState)
+		require.Equal(t, "modbus-rtu", device.Properties["IOTech_ProtocolName"])
+		require.Equal(t, "/dev/virtualport", device.Protocols["modbus-rtu"]["Address"])
+		require.Len(t, device.AutoEvents, 1)
+		require.Equal(t, "1s", device.AutoEvents[0].Interval)
+	}
+}
+
+func TestConvertDeviceCSV_InvalidFile(t *testing.T) {
+	_, edgexErr := ConvertDeviceCSV(fstest.MapFS{
+		"Devices.csv": {Data: []byte("Name,\"ServiceName\n")},
+	}, ImportOptions{})
+	require.Error(t, edgexErr)
+}
@@ -31,6 +31,8 @@ type deviceXlsx struct {
 	baseXlsx
 	devices            []*edgexDtos.Device
 	deviceSchedulesMap map[string][]xrtmodels.Schedule
+	options            ImportOptions
+	importReport       []ImportIssue
 }
 
 func newDeviceXlsx(file io.Reader) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
@@ -39,7 +41,11 @@ func newDeviceXlsx(file io.Reader) (Converter[[]*edgexDtos.Device], errors.EdgeX
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
+	return newDeviceWorkbook(f)
+}
 
+// newDeviceWorkbook creates the Device Converter of the opened workbook, e.g. the workbook loaded from the CSV files
+func newDeviceWorkbook(f *excelize.File) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
 	fieldMappings, edgexErr := convertMappingTable(f)
 	if edgexErr != nil {
 		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
@@ -99,20 +105,25 @@ func (deviceXlsx *deviceXlsx) ConvertToDTO() errors.EdgeX {
 		convertedDevice := edgexDtos.Device{Properties: map[string]any{common.ProtocolName: protocol}}
 		_, err = readStruct(&convertedDevice, header, row, deviceXlsx.fieldMappings)
 		if err != nil {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx row into Device DTO", err)
+			err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx row into Device DTO", err)
+			if edgexErr = deviceXlsx.reportRowError(devicesSheetName, rowIndex, err); edgexErr != nil {
+				return edgexErr
+			}
+			continue
 		}
 
-		for k, v := range convertedDevice.Protocols {
-			err = ToXrtProperties(k, v)
-			if err != nil {
-				return errors.NewCommonEdgeX(errors.Kind(err), "failed to convert EdgeX protocol properties to device connector protocol properties", err)
+		if err = convertXrtProtocols(convertedDevice.Protocols); err != nil {
+			if edgexErr = deviceXlsx.reportRowError(devicesSheetName, rowIndex, err); edgexErr != nil {
+				return edgexErr
 			}
+			continue
 		}
 
 		// validate the device DTO
 		err = edgexCommon.Validate(convertedDevice)
 		if err != nil {
 			deviceXlsx.validateErrors[convertedDevice.Name] = err
+			deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(devicesSheetName, rowIndex, err))
 		} else {
 			deviceXlsx.devices = append(deviceXlsx.devices, &convertedDevice)
 		}
@@ -207,18 +218,27 @@ OUTER:
 		autoEvent := edgexDtos.AutoEvent{}
 		deviceNameResult, edgexErr := readStruct(&autoEvent, header, row, deviceXlsx.fieldMappings)
 		if edgexErr != nil {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an excel row into AutoEvent DTO", err)
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an excel row into AutoEvent DTO", edgexErr)
+			if edgexErr = deviceXlsx.reportRowError(autoEventsSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			continue
 		}
 
 		deviceNames, ok := deviceNameResult.([]string)
 		if !ok {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid,
 				fmt.Sprintf("failed to obtain the 'Reference Device Name' cell of the xlsx row from %s worksheet", autoEventsSheetName), nil)
+			if edgexErr = deviceXlsx.reportRowError(autoEventsSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			continue
 		}
 
 		// validate the AutoEvent DTO
 		err = edgexCommon.Validate(autoEvent)
 		if err != nil {
+			deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(autoEventsSheetName, rowIndex, err))
 			for _, deviceName := range deviceNames {
 				// find the matched device DTO index equals to the "Reference Device Name" on the AutoEvents row
 				idx := slices.IndexFunc(deviceXlsx.devices, func(d *edgexDtos.Device) bool { return d.Name == deviceName })
@@ -290,18 +310,27 @@ OUTER:
 		schedule := xrtmodels.Schedule{}
 		deviceNameResult, edgexErr := readStruct(&schedule, header, row, deviceXlsx.fieldMappings)
 		if edgexErr != nil {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an excel row into Schedule DTO", edgexErr)
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an excel row into Schedule DTO", edgexErr)
+			if edgexErr = deviceXlsx.reportRowError(schedulesSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			continue
 		}
 
 		deviceNames, ok := deviceNameResult.([]string)
 		if !ok {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid,
 				fmt.Sprintf("failed to obtain the 'Reference Device Name' cell of the xlsx row from %s worksheet", schedulesSheetName), nil)
+			if edgexErr = deviceXlsx.reportRowError(schedulesSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			continue
 		}
 
 		// validate the Schedule DTO
 		err = edgexCommon.Validate(schedule)
 		if err != nil {
+			deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(schedulesSheetName, rowIndex, err))
 			for _, deviceName := range deviceNames {
 				// find the matched device DTO index equals to the "Reference Device Name" on the Schedules row
 				idx := slices.IndexFunc(deviceXlsx.devices, func(d *edgexDtos.Device) bool { return d.Name == deviceName })
@@ -374,6 +403,29 @@ func (deviceXlsx *deviceXlsx) parseSchedulesHeader(header []string, rowCount int
 	return nil
 }
 
+// reportRowError adds the error of the row to the import report, and returns nil if the import continues with the next
+// row, which is only allowed if all the errors are collected, otherwise returns the error aborting the import, which
+// locates the issue by the sheet, row, column and value since the import report isn't returned to the caller
+func (deviceXlsx *deviceXlsx) reportRowError(sheetName string, rowIndex int, err error) errors.EdgeX {
+	issue := newImportIssue(sheetName, rowIndex, err)
+	deviceXlsx.importReport = append(deviceXlsx.importReport, issue)
+	if deviceXlsx.options.CollectAllErrors {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.Kind(err), issue.location(), err)
+}
+
+// convertXrtProtocols converts the protocol properties of the device to the device connector protocol properties
+func convertXrtProtocols(prtProps map[string]edgexDtos.ProtocolProperties) errors.EdgeX {
+	for k, v := range prtProps {
+		err := ToXrtProperties(k, v)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "failed to convert EdgeX protocol properties to device connector protocol properties", err)
+		}
+	}
+	return nil
+}
+
 // startsWithAutoEvents checks if the path name defined in MappingTable sheet starts with autoEvents
 func startsWithAutoEvents(path string) bool {
 	return strings.HasPrefix(strings.ToLower(path), strings.ToLower(autoEvents))
@@ -396,3 +448,8 @@ func (deviceXlsx *deviceXlsx) GetSchedulesByDeviceName(name string) []xrtmodels.
 func (deviceXlsx *deviceXlsx) GetValidateErrors() map[string]error {
 	return deviceXlsx.validateErrors
 }
+
+// GetImportReport returns all the problems found while parsing the worksheets
+func (deviceXlsx *deviceXlsx) GetImportReport() []ImportIssue {
+	return deviceXlsx.importReport
+}
@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2025 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
@@ -35,7 +35,12 @@ func newDeviceProfileXlsx(file io.Reader) (Converter[*edgexDtos.DeviceProfile],
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
+	return newDeviceProfileWorkbook(f)
+}
 
+// newDeviceProfileWorkbook creates the DeviceProfile Converter of the opened workbook, e.g. the workbook loaded from the
+// CSV files
+func newDeviceProfileWorkbook(f *excelize.File) (Converter[*edgexDtos.DeviceProfile], errors.EdgeX) {
 	fieldMappings, edgexErr := convertMappingTable(f)
 	if edgexErr != nil {
 		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
@@ -1,13 +1,17 @@
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 
 package xlsx
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -15,10 +19,14 @@ import (
 	"github.com/xuri/excelize/v2"
 )
 
+// defaultSchedulesHeader defines the header row of the Schedules sheet added if the template doesn't define the sheet
+var defaultSchedulesHeader = []any{"Name", resource, "Interval", onChange, bounds, tags, publish, units, scheduleOptions, refDeviceName}
+
 // devicesXlsxWriter stores the worksheets processed result and the converted []Device DTO
 type devicesXlsxWriter struct {
 	baseXlsx
-	devices []edgexDtos.Device
+	devices   []edgexDtos.Device
+	schedules map[string][]xrtmodels.Schedule // schedules defines the XRT Schedules keyed by device name
 }
 
 // ConvertToXlsx converts the []Device DTO into xlsx file
@@ -35,6 +43,14 @@ func (deviceWriter *devicesXlsxWriter) ConvertToXlsx() errors.EdgeX {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 
+	// convert to the Schedules sheet
+	if len(deviceWriter.schedules) > 0 {
+		edgexErr = deviceWriter.convertSchedules()
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+
 	return nil
 }
 
@@ -235,6 +251,120 @@ func (deviceWriter *devicesXlsxWriter) convertAutoEvents() errors.EdgeX {
 	return nil
 }
 
+// convertSchedules converts the XRT Schedules of the devices into the Schedules worksheet, the worksheet is added with
+// the default header row if not defined in the template
+func (deviceWriter *devicesXlsxWriter) convertSchedules() errors.EdgeX {
+	f := deviceWriter.xlsFile
+	if !slices.Contains(f.GetSheetList(), schedulesSheetName) {
+		_, err := f.NewSheet(schedulesSheetName)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to add %s worksheet", schedulesSheetName), err)
+		}
+		header := defaultSchedulesHeader
+		err = f.SetSheetRow(schedulesSheetName, "A1", &header)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the header row in the '%s' sheet", schedulesSheetName), err)
+		}
+	}
+
+	rows, err := f.GetRows(schedulesSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet", schedulesSheetName), err)
+	}
+
+	if len(rows) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no header row defined in %s worksheet", schedulesSheetName), nil)
+	}
+
+	headerRow := rows[0]
+	structPtr := &xrtmodels.Schedule{}
+	v := reflect.ValueOf(structPtr)
+	totalScheduleCount := 0
+
+	// iterate the devices instead of the schedules map to write the rows in the device order
+	for _, device := range deviceWriter.devices {
+		for _, schedule := range deviceWriter.schedules[device.Name] {
+			for colIndex, headerCell := range headerRow {
+				if headerCell == "" {
+					continue
+				}
+
+				var cell any
+				field := v.Elem().FieldByName(headerCell)
+				if field.Kind() != reflect.Invalid {
+					// header matches the Schedule field name, the Device field is skipped as the device name is set to
+					// the Reference Device Name column
+					switch strings.ToLower(headerCell) {
+					case edgexCommon.Name:
+						cell = schedule.Name
+					case strings.ToLower(resource):
+						cell = strings.Join(schedule.Resource, edgexCommon.CommaSeparator)
+					case edgexCommon.Interval:
+						// the interval is stored as the duration string which is parsed back to microseconds
+						cell = (time.Duration(schedule.Interval) * time.Microsecond).String() // #nosec G115
+					case strings.ToLower(onChange):
+						cell = schedule.OnChange
+					case strings.ToLower(publish):
+						cell = schedule.Publish
+					case strings.ToLower(units):
+						cell = schedule.Units
+					case strings.ToLower(bounds):
+						if schedule.Bounds != nil {
+							cell, err = marshalCell(schedule.Bounds)
+						}
+					case strings.ToLower(tags):
+						if schedule.Tags != nil {
+							cell, err = marshalCell(schedule.Tags)
+						}
+					case strings.ToLower(scheduleOptions):
+						if options, ok := schedule.Options.(string); ok {
+							cell = options
+						} else if schedule.Options != nil {
+							// the non-string options are written as JSON, which is decoded again on read
+							cell, err = marshalCell(schedule.Options)
+						}
+					default:
+						continue
+					}
+					if err != nil {
+						return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert '%s' field of schedule '%s' to the %s worksheet", headerCell, schedule.Name, schedulesSheetName), err)
+					}
+				} else if strings.EqualFold(headerCell, refDeviceName) {
+					// set device name to cell if header is "Reference Device Name"
+					cell = device.Name
+				}
+
+				if cell == nil || cell == "" {
+					continue
+				}
+
+				// get the current column name of the cell will be set
+				columnName, err := excelize.ColumnNumberToName(colIndex + 1)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert column number %d to name all rows from %s worksheet", colIndex+1, schedulesSheetName), err)
+				}
+
+				err = f.SetCellValue(schedulesSheetName, fmt.Sprintf("%s%d", columnName, totalScheduleCount+2), cell)
+				if err != nil {
+					return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", schedulesSheetName), err)
+				}
+			}
+
+			totalScheduleCount++
+		}
+	}
+	return nil
+}
+
+// marshalCell marshals the map or object field to the JSON cell value
+func marshalCell(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // getNestedMapValue get the value of map from the passed MappingTable Path in the worksheet
 // e.g., modbus-rtu.Address returns the nested 'Address' value from device protocol properties map
 func getNestedMapValue(fieldNames []string, topLevelMap map[string]any) (any, errors.EdgeX) {
@@ -1,4 +1,4 @@
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 
 package xlsx
 
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 
 	"github.com/stretchr/testify/require"
@@ -192,6 +193,43 @@ func Test_deviceWriter_convertAutoEvents(t *testing.T) {
 	require.Equal(t, mockAutoEvent.Interval, value)
 }
 
+func Test_deviceWriter_convertSchedules(t *testing.T) {
+	f, buffer, err := initialDevicesXlsxFileReader()
+	require.NoError(t, err)
+	defer f.Close()
+
+	mockSchedule := xrtmodels.Schedule{
+		Name:     "schedule-1",
+		Resource: []string{"analog_input_0:present-value", "analog_input_1:present-value"},
+		Interval: 1_500_000,
+		OnChange: true,
+		Bounds:   map[string]float64{"analog_input_0:present-value": 0.1},
+		Publish:  true,
+		Options:  map[string]any{"priority": 1},
+	}
+	xlsxWriter, err := newXlsxWriter(mockDevices, buffer)
+	require.NoError(t, err)
+	xlsxWriter.(*devicesXlsxWriter).schedules = map[string][]xrtmodels.Schedule{
+		mockDevice.Name:  {mockSchedule},
+		"unknown-device": {mockSchedule},
+	}
+
+	// the Schedules sheet is added with the default header as the template doesn't define it
+	err = xlsxWriter.(*devicesXlsxWriter).convertSchedules()
+	require.NoError(t, err)
+
+	fileReader := xlsxWriter.(*devicesXlsxWriter).xlsFile
+	defer xlsxWriter.(*devicesXlsxWriter).xlsFile.Close()
+
+	rows, err := fileReader.GetRows(schedulesSheetName)
+	require.NoError(t, err)
+	require.Len(t, rows, 2, "only the schedules of the converted devices should be written")
+	require.Equal(t, []string{
+		"schedule-1", "analog_input_0:present-value,analog_input_1:present-value", "1.5s", "TRUE",
+		`{"analog_input_0:present-value":0.1}`, "", "TRUE", "FALSE", `{"priority":1}`, mockDevice.Name,
+	}, rows[1])
+}
+
 func Test_getNestedMapValue(t *testing.T) {
 	mockFieldNames := []string{"foo", "bar"}
 	expectedValue := "baz"
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 
 package xlsx
@@ -30,7 +30,11 @@ func newXlsxWriter[T AllowedDTOConverterTypes](s T, xlsxReader io.Reader) (DTOCo
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to open xlsx template file from io.Reader", err)
 	}
+	return newWorkbookWriter(s, f)
+}
 
+// newWorkbookWriter creates the DTOConverter writing to the opened template workbook
+func newWorkbookWriter[T AllowedDTOConverterTypes](s T, f *excelize.File) (DTOConverter[T], errors.EdgeX) {
 	switch any(s).(type) {
 	case []edgexDtos.Device:
 		fieldMappings, edgexErr := convertMappingTable(f)
@@ -0,0 +1,173 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format is the file format of the worksheets defining the devices and device profiles, all the formats define the
+// same worksheets, e.g. MappingTable, Devices, AutoEvents and Schedules, and are converted in the same way
+type Format string
+
+const (
+	// FormatXlsx is the xlsx workbook
+	FormatXlsx Format = "xlsx"
+	// FormatCSV is the zip archive of the CSV files named by the worksheets, e.g. Devices.csv, see also ConvertDeviceCSV
+	// to convert a directory of the CSV files
+	FormatCSV Format = "csv"
+	// FormatJSONL is the JSON Lines file of the worksheet rows, e.g. {"sheet":"Devices","cells":["Sensor01","10.0.0.1"]},
+	// the first row of each worksheet is the header row
+	FormatJSONL Format = "jsonl"
+)
+
+// FormatOf returns the format of the file by the file extension, which is .xlsx, .zip of the CSV files, or .jsonl
+func FormatOf(fileName string) (Format, errors.EdgeX) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".xlsx":
+		return FormatXlsx, nil
+	case ".zip":
+		return FormatCSV, nil
+	case ".jsonl":
+		return FormatJSONL, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported file extension of '%s'", fileName), nil)
+	}
+}
+
+// openWorkbook opens the file of the format as the workbook
+func openWorkbook(format Format, file io.Reader) (*excelize.File, errors.EdgeX) {
+	switch format {
+	case FormatXlsx:
+		f, err := excelize.OpenReader(file)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to open xlsx file from io.Reader", err)
+		}
+		return f, nil
+	case FormatCSV:
+		return readCSVArchive(file)
+	case FormatJSONL:
+		return readJSONLWorkbook(file)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported file format '%s'", format), nil)
+	}
+}
+
+// ConvertDeviceFile converts the file of the format to Device DTOs by the options
+func ConvertDeviceFile(format Format, file io.Reader, options ImportOptions) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
+	f, edgexErr := openWorkbook(format, file)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return convertDeviceWorkbook(f, options)
+}
+
+// ConvertDeviceProfileFile converts the file of the format to DeviceProfile DTO
+func ConvertDeviceProfileFile(format Format, file io.Reader) (Converter[*edgexDtos.DeviceProfile], error) {
+	f, edgexErr := openWorkbook(format, file)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+	return convertDeviceProfileWorkbook(f)
+}
+
+// ConvertToFile converts the DTOs to the file of the format by the template file of the same format and writes to
+// io.Writer
+func ConvertToFile[T AllowedDTOConverterTypes](format Format, templateReader io.Reader, w io.Writer, convertData T) errors.EdgeX {
+	formatWriter, edgexErr := newFormatWriter(format, convertData, templateReader)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	return writeXlsx[T](formatWriter, w)
+}
+
+// ConvertDevicesToFile converts the Device DTOs and their XRT Schedules keyed by device name to the file of the format
+// by the template file of the same format and writes to io.Writer
+func ConvertDevicesToFile(format Format, templateReader io.Reader, w io.Writer, devices []edgexDtos.Device,
+	schedules map[string][]xrtmodels.Schedule) errors.EdgeX {
+	formatWriter, edgexErr := newFormatWriter(format, devices, templateReader)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	formatWriter.DTOConverter.(*devicesXlsxWriter).schedules = schedules
+	return writeXlsx[[]edgexDtos.Device](formatWriter, w)
+}
+
+// formatWriter writes the worksheets converted by the xlsx DTOConverter in the file format
+type formatWriter[T AllowedDTOConverterTypes] struct {
+	DTOConverter[T]
+	xlsFile *excelize.File
+	format  Format
+}
+
+func newFormatWriter[T AllowedDTOConverterTypes](format Format, s T, templateReader io.Reader) (*formatWriter[T], errors.EdgeX) {
+	f, edgexErr := openWorkbook(format, templateReader)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to open %s template file from io.Reader", format), edgexErr)
+	}
+	xlsxWriter, edgexErr := newWorkbookWriter(s, f)
+	if edgexErr != nil {
+		_ = f.Close()
+		return nil, edgexErr
+	}
+	return &formatWriter[T]{DTOConverter: xlsxWriter, xlsFile: f, format: format}, nil
+}
+
+// Write writes the worksheets to io.Writer in the file format
+func (fw *formatWriter[T]) Write(w io.Writer) errors.EdgeX {
+	switch fw.format {
+	case FormatCSV:
+		return writeCSVArchive(fw.xlsFile, w)
+	case FormatJSONL:
+		return writeJSONL(fw.xlsFile, w)
+	default:
+		return fw.DTOConverter.Write(w)
+	}
+}
+
+// newSheetsWorkbook creates the workbook of the worksheet rows in the order of the sheet names
+func newSheetsWorkbook(sheetNames []string, sheetRows map[string][][]string) (*excelize.File, errors.EdgeX) {
+	f := excelize.NewFile()
+	defaultSheetName := f.GetSheetName(0)
+	for _, sheetName := range sheetNames {
+		_, err := f.NewSheet(sheetName)
+		if err != nil {
+			_ = f.Close()
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to add %s worksheet", sheetName), err)
+		}
+		for rowIndex, row := range sheetRows[sheetName] {
+			if len(row) == 0 {
+				continue
+			}
+			cells := make([]any, len(row))
+			for i, cell := range row {
+				cells[i] = cell
+			}
+			err = f.SetSheetRow(sheetName, fmt.Sprintf("A%d", rowIndex+1), &cells)
+			if err != nil {
+				_ = f.Close()
+				return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set row %d in the '%s' sheet", rowIndex+1, sheetName), err)
+			}
+		}
+	}
+
+	// remove the default worksheet of the new workbook if not defined
+	if len(sheetNames) > 0 && !slices.ContainsFunc(sheetNames, func(name string) bool { return strings.EqualFold(name, defaultSheetName) }) {
+		err := f.DeleteSheet(defaultSheetName)
+		if err != nil {
+			_ = f.Close()
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to delete %s worksheet", defaultSheetName), err)
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFormatOf(t *testing.T) {
+	tests := []struct {
+		fileName    string
+		expected    Format
+		expectedErr bool
+	}{
+		{"BACnet-IP_Device.xlsx", FormatXlsx, false},
+		{"devices.ZIP", FormatCSV, false},
+		{"profile.jsonl", FormatJSONL, false},
+		{"devices.csv", "", true},
+		{"devices", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			format, edgexErr := FormatOf(tt.fileName)
+			if tt.expectedErr {
+				require.Error(t, edgexErr)
+				return
+			}
+			require.NoError(t, edgexErr)
+			require.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+// convertXlsxFormat converts the xlsx file content to the file content of the format
+func convertXlsxFormat(t *testing.T, data []byte, format Format) []byte {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var buffer bytes.Buffer
+	switch format {
+	case FormatCSV:
+		require.NoError(t, writeCSVArchive(f, &buffer))
+	case FormatJSONL:
+		require.NoError(t, writeJSONL(f, &buffer))
+	default:
+		buffer.Write(data)
+	}
+	return buffer.Bytes()
+}
+
+func TestConvertDeviceFile_SameAsXlsx(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+	conv, edgexErr := ConvertDeviceXlsx(bytes.NewReader(data))
+	require.NoError(t, edgexErr)
+	require.NotEmpty(t, conv.GetDTOs())
+
+	for _, format := range []Format{FormatCSV, FormatJSONL} {
+		t.Run(string(format), func(t *testing.T) {
+			formatConv, edgexErr := ConvertDeviceFile(format, bytes.NewReader(convertXlsxFormat(t, data, format)), ImportOptions{})
+			require.NoError(t, edgexErr)
+			require.Equal(t, conv.GetDTOs(), formatConv.GetDTOs())
+			require.Equal(t, conv.GetValidateErrors(), formatConv.GetValidateErrors())
+			for _, device := range conv.GetDTOs() {
+				require.Equal(t, conv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name),
+					formatConv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name))
+			}
+		})
+	}
+}
+
+func TestConvertDeviceFile_InvalidFile(t *testing.T) {
+	_, edgexErr := ConvertDeviceFile(FormatCSV, bytes.NewReader([]byte("not a zip archive")), ImportOptions{})
+	require.Error(t, edgexErr)
+	_, edgexErr = ConvertDeviceFile(FormatJSONL, bytes.NewReader([]byte(`{"cells":["Name"]}`)), ImportOptions{})
+	require.Error(t, edgexErr)
+	_, edgexErr = ConvertDeviceFile("txt", bytes.NewReader(nil), ImportOptions{})
+	require.Error(t, edgexErr)
+}
+
+func TestConvertDevicesToFile_RoundTrip(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+	conv, edgexErr := ConvertDeviceXlsx(bytes.NewReader(data))
+	require.NoError(t, edgexErr)
+	devices := make([]edgexDtos.Device, 0, len(conv.GetDTOs()))
+	schedules := make(map[string][]xrtmodels.Schedule)
+	for _, device := range conv.GetDTOs() {
+		devices = append(devices, *device)
+		schedules[device.Name] = conv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name)
+	}
+	// the non-string options are written as JSON and decoded on read, the JSON numbers as float64
+	schedules["Sensor0002"] = slices.Clone(schedules["Sensor0002"])
+	schedules["Sensor0002"][0].Options = map[string]any{"priority": float64(1), "labels": []any{"a", "b"}}
+
+	template := newTestdataDevicesTemplate(t, data)
+
+	for _, format := range []Format{FormatCSV, FormatJSONL} {
+		t.Run(string(format), func(t *testing.T) {
+			var outputBuffer bytes.Buffer
+			templateReader := bytes.NewReader(convertXlsxFormat(t, template, format))
+			edgexErr := ConvertDevicesToFile(format, templateReader, &outputBuffer, devices, schedules)
+			require.NoError(t, edgexErr)
+
+			reConv, edgexErr := ConvertDeviceFile(format, &outputBuffer, ImportOptions{})
+			require.NoError(t, edgexErr)
+			require.Equal(t, conv.GetDTOs(), reConv.GetDTOs())
+			for _, device := range reConv.GetDTOs() {
+				require.Equal(t, schedules[device.Name], reConv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name))
+			}
+		})
+	}
+}
+
+func TestConvertToFile_DeviceProfile(t *testing.T) {
+	f, err := createXlsxTemplateFile()
+	require.NoError(t, err)
+	defer f.Close()
+	var jsonlBuffer bytes.Buffer
+	require.NoError(t, writeJSONL(f, &jsonlBuffer))
+
+	var outputBuffer bytes.Buffer
+	edgexErr := ConvertToFile(FormatJSONL, &jsonlBuffer, &outputBuffer, mockDeviceProfile)
+	require.NoError(t, edgexErr)
+
+	written, edgexErr := readJSONLWorkbook(&outputBuffer)
+	require.NoError(t, edgexErr)
+	defer written.Close()
+	rows, err := written.GetRows(deviceInfoSheetName)
+	require.NoError(t, err)
+	require.Contains(t, rows, []string{"Name", mockProfileName})
+}
+
+func Test_newSheetsWorkbook(t *testing.T) {
+	f, edgexErr := newSheetsWorkbook([]string{devicesSheetName, mappingTableSheetName}, map[string][][]string{
+		devicesSheetName:      {{"Name"}, {}, {"Sensor01"}},
+		mappingTableSheetName: {{"Object", "Path", "Default Value"}},
+	})
+	require.NoError(t, edgexErr)
+	defer f.Close()
+
+	require.Equal(t, []string{devicesSheetName, mappingTableSheetName}, f.GetSheetList())
+	rows, err := f.GetRows(devicesSheetName)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"Name"}, nil, {"Sensor01"}}, rows, "the empty row should keep its position")
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// jsonlRow is the worksheet row of the JSON Lines file
+// The cells may be any JSON value, the numbers and booleans are converted to the cell text, and the objects and arrays
+// are converted to the JSON text, e.g. the Bounds of the Schedules sheet
+type jsonlRow struct {
+	Sheet string            `json:"sheet"`
+	Cells []json.RawMessage `json:"cells"`
+}
+
+// maxJSONLLineSize is the maximum size of the line in the JSON Lines file, the rows of the worksheets with many columns
+// exceed the default token size of bufio.Scanner
+const maxJSONLLineSize = 16 * 1024 * 1024
+
+// readJSONLWorkbook loads the rows of the JSON Lines file to the worksheets in the order of the first row of each sheet,
+// the blank lines are skipped
+func readJSONLWorkbook(file io.Reader) (*excelize.File, errors.EdgeX) {
+	var sheetNames []string
+	sheetRows := make(map[string][][]string)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxJSONLLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		var row jsonlRow
+		err := json.Unmarshal(data, &row)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode JSON line %d", line), err)
+		}
+		if row.Sheet == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no sheet defined in JSON line %d", line), nil)
+		}
+
+		cells := make([]string, len(row.Cells))
+		for i, rawCell := range row.Cells {
+			cells[i], err = jsonlCell(rawCell)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to convert cell %d in JSON line %d", i+1, line), err)
+			}
+		}
+		if _, ok := sheetRows[row.Sheet]; !ok {
+			sheetNames = append(sheetNames, row.Sheet)
+		}
+		sheetRows[row.Sheet] = append(sheetRows[row.Sheet], cells)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read JSON Lines file from io.Reader", err)
+	}
+	return newSheetsWorkbook(sheetNames, sheetRows)
+}
+
+// jsonlCell returns the cell text of the JSON value
+func jsonlCell(rawCell json.RawMessage) (string, error) {
+	var text string
+	if err := json.Unmarshal(rawCell, &text); err == nil {
+		return text, nil
+	}
+	if string(rawCell) == "null" {
+		return "", nil
+	}
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, rawCell); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// writeJSONL writes the rows of the worksheets in the workbook to the JSON Lines file, the cells are written as JSON
+// strings
+func writeJSONL(f *excelize.File, w io.Writer) errors.EdgeX {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, sheetName := range f.GetSheetList() {
+		rows, err := f.GetRows(sheetName)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet", sheetName), err)
+		}
+		for _, cells := range rows {
+			row := struct {
+				Sheet string   `json:"sheet"`
+				Cells []string `json:"cells"`
+			}{Sheet: sheetName, Cells: cells}
+			if row.Cells == nil {
+				row.Cells = []string{}
+			}
+			err = encoder.Encode(row)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, "failed to write JSON line to io.Writer", err)
+			}
+		}
+	}
+	return nil
+}
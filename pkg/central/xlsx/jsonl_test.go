@@ -0,0 +1,96 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDeviceProfileFile_JSONL(t *testing.T) {
+	lines := []string{
+		`{"sheet":"MappingTable","cells":["Object","Path","Default Value"]}`,
+		`{"sheet":"MappingTable","cells":["ValueType","deviceResources[].properties.valueType","String"]}`,
+		`{"sheet":"DeviceInfo","cells":["Name","Sensor30001Profile"]}`,
+		`{"sheet":"DeviceResource","cells":["Name","IsHidden","Description","ValueType","ReadWrite","primaryTable"]}`,
+		`{"sheet":"DeviceResource","cells":["IP_Curing_time_St_1",true,"St_1","Int16","W","INPUT_REGISTERS"]}`,
+		`{"sheet":"DeviceResource","cells":["IP_Curing_time_St_2",false,null,null,"R","INPUT_REGISTERS"]}`,
+	}
+
+	conv, err := ConvertDeviceProfileFile(FormatJSONL, strings.NewReader(strings.Join(lines, "\n")))
+	require.NoError(t, err)
+	profile := conv.GetDTOs()
+	require.Equal(t, mockProfileName1, profile.Name)
+	require.Len(t, profile.DeviceResources, 2)
+	require.True(t, profile.DeviceResources[0].IsHidden)
+	require.Equal(t, "Int16", profile.DeviceResources[0].Properties.ValueType)
+	require.Equal(t, "String", profile.DeviceResources[1].Properties.ValueType, "the null cell should use the MappingTable default value")
+}
+
+func Test_jsonlCell(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawCell  string
+		expected string
+	}{
+		{"string", `"Sensor01"`, "Sensor01"},
+		{"number", `47808`, "47808"},
+		{"boolean", `true`, "true"},
+		{"null", `null`, ""},
+		{"object", `{ "start": "09:00" }`, `{"start":"09:00"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cell, err := jsonlCell(json.RawMessage(tt.rawCell))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, cell)
+		})
+	}
+}
+
+func Test_readJSONLWorkbook_BlankLines(t *testing.T) {
+	f, edgexErr := readJSONLWorkbook(strings.NewReader("\n" + `{"sheet":"Devices","cells":["Name"]}` + "\r\n  \n" + `{"sheet":"Devices","cells":["Sensor01"]}` + "\n"))
+	require.NoError(t, edgexErr)
+	defer f.Close()
+	rows, err := f.GetRows(devicesSheetName)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"Name"}, {"Sensor01"}}, rows)
+}
+
+func Test_readJSONLWorkbook_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{"truncated line", `{"sheet":"Devices","cells":["Name"]}` + "\n\n" + `{"sheet":`, "failed to decode JSON line 3"},
+		{"multi-line value", "{\n" + `"sheet":"Devices","cells":["Name"]}`, "failed to decode JSON line 1"},
+		{"two values in a line", `{"sheet":"Devices","cells":[]} {"sheet":"Devices","cells":[]}`, "failed to decode JSON line 1"},
+		{"no sheet", "\n" + `{"cells":["Name"]}`, "no sheet defined in JSON line 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, edgexErr := readJSONLWorkbook(strings.NewReader(tt.content))
+			require.Error(t, edgexErr)
+			require.Contains(t, edgexErr.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func Test_writeJSONL(t *testing.T) {
+	f, edgexErr := newSheetsWorkbook([]string{devicesSheetName}, map[string][][]string{
+		devicesSheetName: {{"Name", "Labels"}, {}, {"Sensor01", "<a&b>"}},
+	})
+	require.NoError(t, edgexErr)
+	defer f.Close()
+
+	var buffer bytes.Buffer
+	require.NoError(t, writeJSONL(f, &buffer))
+	require.Equal(t, `{"sheet":"Devices","cells":["Name","Labels"]}`+"\n"+
+		`{"sheet":"Devices","cells":[]}`+"\n"+
+		`{"sheet":"Devices","cells":["Sensor01","<a&b>"]}`+"\n", buffer.String())
+}
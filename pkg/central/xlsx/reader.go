@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2025 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
@@ -170,7 +170,14 @@ func parseCellToField(originValue string, kind reflect.Kind) (any, errors.EdgeX)
 		}
 		fieldValue = uintValue
 	case reflect.Interface:
-		fieldValue = originValue
+		// the non-string values, e.g. the Options of a Schedule, are written as JSON objects or arrays
+		if strings.HasPrefix(originValue, "{") || strings.HasPrefix(originValue, "[") {
+			if err := json.Unmarshal([]byte(originValue), &fieldValue); err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to unmarshal JSON cell '%s'", originValue), err)
+			}
+		} else {
+			fieldValue = originValue
+		}
 	default:
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse originValue '%v' to %s type", originValue, kind), nil)
 	}
@@ -194,7 +201,7 @@ func convertDTOStdTypeFields(rowElement *reflect.Value, xlsxRow []string, header
 
 			err := setStdStructFieldValue(fieldValue, field)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(headerName, fieldValue, err)
 			}
 		} else {
 			// field not found in the DTO struct, skip this column
@@ -228,7 +235,7 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 			// header matches the Device DTO field name (one of the Name, Description, AdminState, OperatingState, etc)
 			err := setStdStructFieldValue(fieldValue, field)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(headerName, fieldValue, err)
 			}
 		} else {
 			// header not belongs to the above fields with standard types
@@ -352,7 +359,7 @@ func convertAutoEventFields(rowElement *reflect.Value, xlsxRow []string, headerC
 			// header matches the AutoEvent DTO field name (one of the Interval, OnChange, SourceName field)
 			err := setStdStructFieldValue(fieldValue, field)
 			if err != nil {
-				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return nil, newCellError(headerName, fieldValue, err)
 			}
 		} else {
 			// only collect the cell as a device name when the column is the "Reference Device Name" column
@@ -399,7 +406,7 @@ func convertScheduleFields(rowElement *reflect.Value, xlsxRow []string, headerCo
 				}
 				interval, err := parseScheduleInterval(fieldValue)
 				if err != nil {
-					return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+					return nil, newCellError(headerName, fieldValue, err)
 				}
 				field.SetUint(interval)
 				continue
@@ -407,7 +414,7 @@ func convertScheduleFields(rowElement *reflect.Value, xlsxRow []string, headerCo
 
 			err := setStdStructFieldValue(fieldValue, field)
 			if err != nil {
-				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return nil, newCellError(headerName, fieldValue, err)
 			}
 		} else {
 			// only collect the cell as a device name when the column is the "Reference Device Name" column
@@ -490,7 +497,7 @@ func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerC
 			// header matches the DeviceResource field name (one of the Name, Description or IsHidden field name)
 			err := setStdStructFieldValue(fieldValue, field)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(headerName, fieldValue, err)
 			}
 		} else {
 			resPropField := rowElement.FieldByName(properties).FieldByName(headerName)
@@ -498,7 +505,7 @@ func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerC
 				// header matches the ResourceProperties DTO field name (one of the ValueType, ReadWrite, Units, etc)
 				err := setStdStructFieldValue(fieldValue, resPropField)
 				if err != nil {
-					return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+					return newCellError(headerName, fieldValue, err)
 				}
 			} else {
 				// set the cell to Attributes map if header not belongs to Properties field
@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2025 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
@@ -127,6 +127,10 @@ func TestParseCellToField(t *testing.T) {
 		{"success to parse a cell to float32 field", "0.9", reflect.Float32, float32(0.9), false},
 		{"success to parse a cell to int64 field", "54321", reflect.Int64, int64(54321), false},
 		{"success to parse a cell to uint32 field", "12345678", reflect.Uint32, uint32(12345678), false},
+		{"success to parse a string cell to interface field", "high", reflect.Interface, "high", false},
+		{"success to parse a JSON object cell to interface field", `{"priority":1}`, reflect.Interface, map[string]any{"priority": float64(1)}, false},
+		{"success to parse a JSON array cell to interface field", `["a",true]`, reflect.Interface, []any{"a", true}, false},
+		{"fail to parse an invalid JSON cell to interface field", `{"priority":`, reflect.Interface, nil, true},
 		{"fail to parse a cell to a unhandled type field", "12345678", reflect.Chan, "", true},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,81 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	goErrors "errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ImportOptions defines how the xlsx file is imported, the import aborts on the first invalid cell by the zero value
+type ImportOptions struct {
+	// CollectAllErrors skips the rows with invalid cells and reports them by the ImportReporter instead of aborting
+	// the import, so that all the problems of the xlsx file can be fixed in one pass
+	CollectAllErrors bool
+}
+
+// ImportIssue describes a problem of the xlsx row found while importing the xlsx file
+type ImportIssue struct {
+	Sheet string `json:"sheet"`
+	// Row is the 1-based row number of the worksheet
+	Row int `json:"row"`
+	// Column and Value are the header and the value of the offending cell, which are empty if the problem isn't caused
+	// by a single cell, e.g. the converted DTO fails the validation
+	Column string `json:"column,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func (i ImportIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.location(), i.Reason)
+}
+
+// location returns the sheet, row and cell of the issue without the reason
+func (i ImportIssue) location() string {
+	if i.Column == "" {
+		return fmt.Sprintf("%s row %d", i.Sheet, i.Row)
+	}
+	return fmt.Sprintf("%s row %d column '%s' value '%s'", i.Sheet, i.Row, i.Column, i.Value)
+}
+
+// ImportReporter exposes all the problems found while importing the xlsx file in the order of the sheets and rows.
+// A Converter[[]*edgexDtos.Device] returned from ConvertDeviceXlsx or ConvertDeviceXlsxWithOptions implements this
+// interface; callers should type-assert to access it.
+type ImportReporter interface {
+	GetImportReport() []ImportIssue
+}
+
+// cellError is the error of the xlsx cell reported with the column header and the cell value
+type cellError struct {
+	column string
+	value  string
+	err    error
+}
+
+func (e cellError) Error() string {
+	return e.err.Error()
+}
+
+func (e cellError) Unwrap() error {
+	return e.err
+}
+
+// newCellError returns the error of the cell which failed to be converted to the DTO field
+func newCellError(headerName, value string, err error) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName),
+		cellError{column: headerName, value: value, err: err})
+}
+
+// newImportIssue returns the import issue of the row, rowIndex is the 0-based index of the rows of the worksheet
+func newImportIssue(sheetName string, rowIndex int, err error) ImportIssue {
+	issue := ImportIssue{Sheet: sheetName, Row: rowIndex + 1, Reason: err.Error()}
+	var cellErr cellError
+	if goErrors.As(err, &cellErr) {
+		issue.Column = cellErr.column
+		issue.Value = cellErr.value
+		issue.Reason = cellErr.Error()
+	}
+	return issue
+}
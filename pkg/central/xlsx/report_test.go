@@ -0,0 +1,98 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createInvalidDeviceXlsxFile(t *testing.T) []byte {
+	f, err := mockExcelFile([]string{devicesSheetName, mappingTableSheetName, schedulesSheetName})
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, createMappingTableSheet(f))
+
+	invalidBaudRateRow := append([]any{}, validDeviceRow...)
+	invalidBaudRateRow[0] = "Sensor30002"
+	invalidBaudRateRow[7] = "fast"
+	for i, row := range [][]any{validDeviceHeader, validDeviceRow, invalidBaudRateRow} {
+		require.NoError(t, f.SetSheetRow(devicesSheetName, fmt.Sprintf("A%d", i+1), &row))
+	}
+
+	scheduleRows := [][]any{
+		{"Interval", "OnChange", "Resource", refDeviceName},
+		{"badformat", "true", "r1", mockDeviceName1},
+		{"1s", "true", "r2", mockDeviceName1},
+	}
+	for i, row := range scheduleRows {
+		require.NoError(t, f.SetSheetRow(schedulesSheetName, fmt.Sprintf("A%d", i+1), &row))
+	}
+
+	buffer, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	return buffer.Bytes()
+}
+
+func TestConvertDeviceXlsxWithOptions_CollectAllErrors(t *testing.T) {
+	data := createInvalidDeviceXlsxFile(t)
+
+	// the import aborts on the first invalid row by default
+	_, edgexErr := ConvertDeviceXlsx(bytes.NewReader(data))
+	require.Error(t, edgexErr)
+	// the error locates the aborting row since the import report isn't returned
+	require.Contains(t, edgexErr.Error(), "Devices row 3")
+	require.Contains(t, edgexErr.Error(), "fail to convert BaudRate to int")
+
+	conv, edgexErr := ConvertDeviceXlsxWithOptions(bytes.NewReader(data), ImportOptions{CollectAllErrors: true})
+	require.NoError(t, edgexErr)
+	devices := conv.GetDTOs()
+	require.Len(t, devices, 1)
+	require.Equal(t, mockDeviceName1, devices[0].Name)
+	schedules := conv.(DeviceScheduleReader).GetSchedulesByDeviceName(mockDeviceName1)
+	require.Len(t, schedules, 1)
+	require.Equal(t, []string{"r2"}, schedules[0].Resource)
+
+	report := conv.(ImportReporter).GetImportReport()
+	require.Len(t, report, 2)
+
+	require.Equal(t, devicesSheetName, report[0].Sheet)
+	require.Equal(t, 3, report[0].Row)
+	require.Empty(t, report[0].Column)
+	require.Contains(t, report[0].Reason, "fail to convert BaudRate to int")
+
+	require.Equal(t, ImportIssue{
+		Sheet:  schedulesSheetName,
+		Row:    2,
+		Column: "Interval",
+		Value:  "badformat",
+		Reason: report[1].Reason,
+	}, report[1])
+	require.Contains(t, report[1].Reason, "invalid interval 'badformat'")
+	require.Equal(t, "Schedules row 2 column 'Interval' value 'badformat': "+report[1].Reason, report[1].String())
+}
+
+func TestStreamDeviceXlsx_AbortOnInvalidCell(t *testing.T) {
+	// the Schedules sheet is parsed before the Devices sheet while streaming
+	report, edgexErr := StreamDeviceXlsx(bytes.NewReader(createInvalidDeviceXlsxFile(t)), ImportOptions{},
+		func(edgexDtos.Device, []xrtmodels.Schedule) error { return nil })
+	require.Error(t, edgexErr)
+	require.Len(t, report, 1)
+	require.Contains(t, edgexErr.Error(), "Schedules row 2 column 'Interval' value 'badformat'")
+	require.Contains(t, edgexErr.Error(), "invalid interval 'badformat'")
+}
+
+func TestNewImportIssue(t *testing.T) {
+	issue := newImportIssue(devicesSheetName, 4, newCellError("Labels", "a,b", bytes.ErrTooLarge))
+	require.Equal(t, ImportIssue{Sheet: devicesSheetName, Row: 5, Column: "Labels", Value: "a,b", Reason: bytes.ErrTooLarge.Error()}, issue)
+
+	issue = newImportIssue(devicesSheetName, 1, bytes.ErrTooLarge)
+	require.Equal(t, ImportIssue{Sheet: devicesSheetName, Row: 2, Reason: bytes.ErrTooLarge.Error()}, issue)
+	require.Equal(t, "Devices row 2: "+bytes.ErrTooLarge.Error(), issue.String())
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DeviceHandler handles the Device DTO streamed from the xlsx file with the Schedules of the device, the streaming
+// stops if an error is returned
+type DeviceHandler func(device edgexDtos.Device, schedules []xrtmodels.Schedule) error
+
+// streamDTOs parses the Devices sheet row by row and passes the Device DTOs to the handler without keeping them
+// The AutoEvents and Schedules sheets are parsed before the Devices sheet to attach the AutoEvents and Schedules to the
+// streamed devices, so only the rows of these sheets are kept in memory
+func (deviceXlsx *deviceXlsx) streamDTOs(handler DeviceHandler) errors.EdgeX {
+	allSheetNames := deviceXlsx.xlsFile.GetSheetList()
+
+	edgexErr := checkRequiredSheets(allSheetNames, requiredSheets)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	// deviceAutoEvents stores the parsed AutoEvents keyed by device name, and invalidDevices stores the validation error
+	// of the AutoEvent or Schedule referenced by the device
+	deviceAutoEvents := make(map[string][]edgexDtos.AutoEvent)
+	invalidDevices := make(map[string]error)
+
+	if slices.Contains(allSheetNames, autoEventsSheetName) {
+		edgexErr = deviceXlsx.streamRows(autoEventsSheetName, startsWithAutoEvents, func(rowIndex int, header, row []string) errors.EdgeX {
+			autoEvent := edgexDtos.AutoEvent{}
+			deviceNames, edgexErr := deviceXlsx.readReferencedRow(&autoEvent, autoEventsSheetName, rowIndex, header, row)
+			if edgexErr != nil || deviceNames == nil {
+				return edgexErr
+			}
+			if err := edgexCommon.Validate(autoEvent); err != nil {
+				deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(autoEventsSheetName, rowIndex, err))
+				for _, deviceName := range deviceNames {
+					invalidDevices[deviceName] = err
+				}
+				return nil
+			}
+			for _, deviceName := range deviceNames {
+				deviceAutoEvents[deviceName] = append(deviceAutoEvents[deviceName], autoEvent)
+			}
+			return nil
+		})
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+
+	if slices.Contains(allSheetNames, schedulesSheetName) {
+		edgexErr = deviceXlsx.streamRows(schedulesSheetName, startsWithSchedules, func(rowIndex int, header, row []string) errors.EdgeX {
+			schedule := xrtmodels.Schedule{}
+			deviceNames, edgexErr := deviceXlsx.readReferencedRow(&schedule, schedulesSheetName, rowIndex, header, row)
+			if edgexErr != nil || deviceNames == nil {
+				return edgexErr
+			}
+			if err := edgexCommon.Validate(schedule); err != nil {
+				deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(schedulesSheetName, rowIndex, err))
+				for _, deviceName := range deviceNames {
+					invalidDevices[deviceName] = err
+					delete(deviceXlsx.deviceSchedulesMap, deviceName)
+				}
+				return nil
+			}
+			for _, deviceName := range deviceNames {
+				if _, ok := invalidDevices[deviceName]; ok {
+					continue
+				}
+				scheduleCopy := schedule
+				scheduleCopy.Device = deviceName
+				deviceXlsx.deviceSchedulesMap[deviceName] = append(deviceXlsx.deviceSchedulesMap[deviceName], scheduleCopy)
+			}
+			return nil
+		})
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+
+	protocol := deviceXlsx.fieldMappings[protocolName].defaultValue
+	isDeviceColumn := func(path string) bool { return !startsWithAutoEvents(path) && !startsWithSchedules(path) }
+	return deviceXlsx.streamRows(devicesSheetName, isDeviceColumn, func(rowIndex int, header, row []string) errors.EdgeX {
+		convertedDevice := edgexDtos.Device{Properties: map[string]any{common.ProtocolName: protocol}}
+		_, edgexErr := readStruct(&convertedDevice, header, row, deviceXlsx.fieldMappings)
+		if edgexErr != nil {
+			edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx row into Device DTO", edgexErr)
+			if edgexErr = deviceXlsx.reportRowError(devicesSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			return nil
+		}
+
+		if edgexErr = convertXrtProtocols(convertedDevice.Protocols); edgexErr != nil {
+			if edgexErr = deviceXlsx.reportRowError(devicesSheetName, rowIndex, edgexErr); edgexErr != nil {
+				return edgexErr
+			}
+			return nil
+		}
+
+		// validate the device DTO, the device is invalid if its AutoEvent or Schedule failed validation
+		convertedDevice.AutoEvents = deviceAutoEvents[convertedDevice.Name]
+		err := edgexCommon.Validate(convertedDevice)
+		if err == nil {
+			err = invalidDevices[convertedDevice.Name]
+		}
+		if err != nil {
+			deviceXlsx.validateErrors[convertedDevice.Name] = err
+			deviceXlsx.importReport = append(deviceXlsx.importReport, newImportIssue(devicesSheetName, rowIndex, err))
+			return nil
+		}
+
+		if err = handler(convertedDevice, deviceXlsx.deviceSchedulesMap[convertedDevice.Name]); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to handle the Device DTO '%s'", convertedDevice.Name), err)
+		}
+		return nil
+	})
+}
+
+// readReferencedRow reads the AutoEvents or Schedules row to the structPtr, and returns the referenced device names,
+// which are nil if the row is skipped for the collected error
+func (deviceXlsx *deviceXlsx) readReferencedRow(structPtr any, sheetName string, rowIndex int, header, row []string) ([]string, errors.EdgeX) {
+	deviceNameResult, edgexErr := readStruct(structPtr, header, row, deviceXlsx.fieldMappings)
+	if edgexErr != nil {
+		edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to unmarshal an excel row from %s worksheet", sheetName), edgexErr)
+	} else if deviceNames, ok := deviceNameResult.([]string); ok {
+		return deviceNames, nil
+	} else {
+		edgexErr = errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("failed to obtain the 'Reference Device Name' cell of the xlsx row from %s worksheet", sheetName), nil)
+	}
+	if edgexErr = deviceXlsx.reportRowError(sheetName, rowIndex, edgexErr); edgexErr != nil {
+		return nil, edgexErr
+	}
+	return nil, nil
+}
+
+// streamRows iterates the data rows of the worksheet by the excelize row iterator, the blank rows are skipped
+// The MappingTable objects with default values matched by isSheetColumn but not defined in the header row are appended to
+// the header and the data rows instead of inserting columns to the worksheet
+func (deviceXlsx *deviceXlsx) streamRows(sheetName string, isSheetColumn func(path string) bool,
+	rowHandler func(rowIndex int, header, row []string) errors.EdgeX) errors.EdgeX {
+	rows, err := deviceXlsx.xlsFile.Rows(sheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to iterate rows from %s worksheet", sheetName), err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var header, defaultValues []string
+	for rowIndex := 0; rows.Next(); rowIndex++ {
+		row, err := rows.Columns()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to read row %d from %s worksheet", rowIndex+1, sheetName), err)
+		}
+
+		if rowIndex == 0 {
+			header = row
+			if sheetName != devicesSheetName && len(header) < 2 {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("%s sheet should define at least 2 columns", sheetName), nil)
+			}
+			var missingHeader []string
+			missingHeader, defaultValues = deviceXlsx.missingMappingColumns(header, isSheetColumn)
+			header = append(header, missingHeader...)
+			continue
+		}
+		if !slices.ContainsFunc(row, func(cell string) bool { return strings.TrimSpace(cell) != "" }) {
+			continue
+		}
+
+		// insert the default values after the header columns, as the cells past the header columns are the extra
+		// device names of the AutoEvents and Schedules sheets
+		headerLength := len(header) - len(defaultValues)
+		var extraCells []string
+		if len(row) > headerLength {
+			extraCells = row[headerLength:]
+			row = row[:headerLength]
+		}
+		row = slices.Concat(row, make([]string, headerLength-len(row)), defaultValues, extraCells)
+
+		if edgexErr := rowHandler(rowIndex, header, row); edgexErr != nil {
+			return edgexErr
+		}
+	}
+	if err = rows.Error(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to iterate rows from %s worksheet", sheetName), err)
+	}
+	if header == nil && sheetName == devicesSheetName {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no header row defined in %s worksheet", devicesSheetName), nil)
+	}
+	return nil
+}
+
+// missingMappingColumns returns the MappingTable objects with default values matched by isSheetColumn which are not
+// defined in the header, and their default values
+func (deviceXlsx *deviceXlsx) missingMappingColumns(header []string, isSheetColumn func(path string) bool) ([]string, []string) {
+	var objects, defaultValues []string
+	for _, objectField := range slices.Sorted(maps.Keys(deviceXlsx.fieldMappings)) {
+		mapping := deviceXlsx.fieldMappings[objectField]
+		if mapping.defaultValue == "" || !isSheetColumn(mapping.path) || slices.Contains(header, objectField) {
+			continue
+		}
+		objects = append(objects, objectField)
+		defaultValues = append(defaultValues, mapping.defaultValue)
+	}
+	return objects, defaultValues
+}
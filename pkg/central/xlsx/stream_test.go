@@ -0,0 +1,115 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bytes"
+	goErrors "errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireStreamedAsConverted checks the streamed devices and schedules equal to the ones converted by ConvertDeviceXlsx
+func requireStreamedAsConverted(t *testing.T, data []byte, options ImportOptions) []ImportIssue {
+	conv, edgexErr := ConvertDeviceXlsxWithOptions(bytes.NewReader(data), options)
+	require.NoError(t, edgexErr)
+
+	var devices []*edgexDtos.Device
+	report, edgexErr := StreamDeviceXlsx(bytes.NewReader(data), options, func(device edgexDtos.Device, schedules []xrtmodels.Schedule) error {
+		devices = append(devices, &device)
+		require.Equal(t, conv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name), schedules)
+		return nil
+	})
+	require.NoError(t, edgexErr)
+	require.Equal(t, conv.GetDTOs(), devices)
+	require.ElementsMatch(t, conv.(ImportReporter).GetImportReport(), report)
+	return report
+}
+
+func TestStreamDeviceXlsx_BACnetXlsx(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+
+	report := requireStreamedAsConverted(t, data, ImportOptions{})
+	require.Empty(t, report)
+}
+
+func TestStreamDeviceXlsx_AutoEvents(t *testing.T) {
+	f, err := mockExcelFile([]string{devicesSheetName, mappingTableSheetName, autoEventsSheetName})
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, createMappingTableSheet(f))
+
+	secondDeviceRow := append([]any{}, validDeviceRow...)
+	secondDeviceRow[0] = "Sensor30002"
+	// the Interval column is added by the MappingTable default value before the extra device name cell
+	autoEventRows := [][]any{
+		{"SourceName", refDeviceName},
+		{"temperature", mockDeviceName1, "Sensor30002"},
+		{"humidity", "Sensor30002"},
+	}
+	for sheetName, rows := range map[string][][]any{
+		devicesSheetName:    {validDeviceHeader, validDeviceRow, {}, secondDeviceRow},
+		autoEventsSheetName: autoEventRows,
+	} {
+		for i, row := range rows {
+			require.NoError(t, f.SetSheetRow(sheetName, fmt.Sprintf("A%d", i+1), &row))
+		}
+	}
+	buffer, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	deviceX, edgexErr := newDeviceXlsx(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, edgexErr)
+	dx := deviceX.(*deviceXlsx)
+	defer dx.xlsFile.Close()
+
+	var devices []edgexDtos.Device
+	edgexErr = dx.streamDTOs(func(device edgexDtos.Device, _ []xrtmodels.Schedule) error {
+		devices = append(devices, device)
+		return nil
+	})
+	require.NoError(t, edgexErr)
+	require.Len(t, devices, 2, "the blank row should be skipped")
+	require.Equal(t, []edgexDtos.AutoEvent{{Interval: "1s", SourceName: "temperature"}}, devices[0].AutoEvents)
+	require.Equal(t, []edgexDtos.AutoEvent{{Interval: "1s", SourceName: "temperature"}, {Interval: "1s", SourceName: "humidity"}}, devices[1].AutoEvents)
+	require.Equal(t, "UP", devices[0].OperatingState)
+
+	// the missing MappingTable columns are not inserted to the workbook
+	header, err := dx.xlsFile.GetRows(autoEventsSheetName)
+	require.NoError(t, err)
+	require.Equal(t, []string{"SourceName", refDeviceName}, header[0])
+	header, err = dx.xlsFile.GetRows(devicesSheetName)
+	require.NoError(t, err)
+	require.Len(t, header[0], len(validDeviceHeader))
+}
+
+func TestStreamDeviceXlsx_CollectAllErrors(t *testing.T) {
+	data := createInvalidDeviceXlsxFile(t)
+
+	_, edgexErr := StreamDeviceXlsx(bytes.NewReader(data), ImportOptions{}, func(edgexDtos.Device, []xrtmodels.Schedule) error { return nil })
+	require.Error(t, edgexErr)
+
+	report := requireStreamedAsConverted(t, data, ImportOptions{CollectAllErrors: true})
+	require.Len(t, report, 2)
+}
+
+func TestStreamDeviceXlsx_HandlerError(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+
+	handled := 0
+	handlerErr := goErrors.New("handler failed")
+	_, edgexErr := StreamDeviceXlsx(bytes.NewReader(data), ImportOptions{}, func(edgexDtos.Device, []xrtmodels.Schedule) error {
+		handled++
+		return handlerErr
+	})
+	require.ErrorIs(t, edgexErr, handlerErr)
+	require.Equal(t, 1, handled, "the streaming should stop on the handler error")
+}
@@ -0,0 +1,300 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// templateRowCount is the number of the data rows covered by the dropdowns of the template worksheets
+const templateRowCount = 1000
+
+// templateProperty defines the protocol property column of the device import template
+type templateProperty struct {
+	object       string   // the Object name of the MappingTable and the header name of the Devices sheet
+	path         string   // the property path under the Device protocols, e.g. BACnet-IP.Port
+	defaultValue string   // the default value of the MappingTable
+	options      []string // the enumerated values offered by the dropdown of the Devices sheet
+}
+
+func protocolProperty(protocol, name, defaultValue string, options ...string) templateProperty {
+	return templateProperty{object: name, path: protocol + mappingPathSeparator + name, defaultValue: defaultValue, options: options}
+}
+
+// etherNetIPConnectionProperties returns the properties of the O2T or T2O implicit connection, the objects are
+// prefixed by the connection as both connections define the same properties
+func etherNetIPConnectionProperties(connection string) []templateProperty {
+	properties := []templateProperty{
+		protocolProperty(connection, common.EtherNetIPConnectionType, "", "p2p", "multicast"),
+		protocolProperty(connection, common.EtherNetIPRPI, ""),
+		protocolProperty(connection, common.EtherNetIPPriority, "", "low", "high", "scheduled", "urgent"),
+		protocolProperty(connection, common.EtherNetIPOwnership, ""),
+	}
+	for i := range properties {
+		properties[i].object = connection + "_" + properties[i].object
+	}
+	return properties
+}
+
+// templateProtocols defines the protocol properties of the device import template for each protocol
+var templateProtocols = map[string][]templateProperty{
+	common.BacnetIP: {
+		protocolProperty(common.BacnetIP, common.BacnetDeviceInstance, ""),
+		protocolProperty(common.BacnetIP, common.BacnetAddress, ""),
+		protocolProperty(common.BacnetIP, common.BacnetPort, "47808"),
+	},
+	common.BacnetMSTP: {
+		protocolProperty(common.BacnetMSTP, common.BacnetDeviceInstance, ""),
+		protocolProperty(common.BacnetMSTP, common.BacnetAddress, ""),
+	},
+	common.Gps: {
+		protocolProperty(common.Gps, common.GpsGpsdPort, "2947"),
+		protocolProperty(common.Gps, common.GpsGpsdRetries, ""),
+		protocolProperty(common.Gps, common.GpsGpsdConnTimeout, ""),
+		protocolProperty(common.Gps, common.GpsGpsdRequestTimeout, ""),
+	},
+	common.ModbusTcp: {
+		protocolProperty(common.ModbusTcp, common.ModbusAddress, ""),
+		protocolProperty(common.ModbusTcp, common.ModbusPort, "502"),
+		protocolProperty(common.ModbusTcp, common.ModbusUnitID, "1"),
+	},
+	common.ModbusRtu: {
+		protocolProperty(common.ModbusRtu, common.ModbusAddress, ""),
+		protocolProperty(common.ModbusRtu, common.ModbusBaudRate, "19200", "1200", "2400", "4800", "9600", "19200", "38400", "57600", "115200"),
+		protocolProperty(common.ModbusRtu, common.ModbusDataBits, "8", "5", "6", "7", "8"),
+		protocolProperty(common.ModbusRtu, common.ModbusParity, "N", "N", "O", "E"),
+		protocolProperty(common.ModbusRtu, common.ModbusStopBits, "1", "1", "2"),
+		protocolProperty(common.ModbusRtu, common.ModbusUnitID, "1"),
+	},
+	common.Opcua: {
+		protocolProperty(common.Opcua, common.OpcuaAddress, ""),
+		protocolProperty(common.Opcua, common.OpcuaRequestedSessionTimeout, ""),
+		protocolProperty(common.Opcua, common.OpcuaSessionKeepAliveInterval, ""),
+		protocolProperty(common.Opcua, common.OpcuaBrowseDepth, ""),
+		protocolProperty(common.Opcua, common.OpcuaReadBatchSize, ""),
+		protocolProperty(common.Opcua, common.OpcuaWriteBatchSize, ""),
+	},
+	common.S7: {
+		protocolProperty(common.S7, common.S7Address, ""),
+		protocolProperty(common.S7, common.S7Rack, "0"),
+		protocolProperty(common.S7, common.S7Slot, "1"),
+	},
+	common.EtherNetIP: slices.Concat(
+		[]templateProperty{protocolProperty(common.EtherNetIP, common.EtherNetIPAddress, "")},
+		etherNetIPConnectionProperties(common.EtherNetIPO2T),
+		etherNetIPConnectionProperties(common.EtherNetIPT2O),
+	),
+}
+
+// TemplateProtocols returns the protocol names supported by GenerateDeviceXlsxTemplate
+func TemplateProtocols() []string {
+	return slices.Sorted(maps.Keys(templateProtocols))
+}
+
+// GenerateDeviceXlsxTemplate generates the blank device import xlsx file of the protocol and writes to io.Writer
+// The MappingTable sheet defines the protocol property paths and defaults, and the Devices, AutoEvents and Schedules
+// sheets offer the dropdowns of the enumerated values. The profile is optional, its name is the default ProfileName and
+// its resource and command names are offered as the AutoEvents and Schedules sources
+func GenerateDeviceXlsxTemplate(protocol string, profile *edgexDtos.DeviceProfile, w io.Writer) errors.EdgeX {
+	f, edgexErr := newDeviceTemplate(protocol, profile)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	defer func() { _ = f.Close() }()
+
+	err := f.Write(w)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write xlsx file to io.Writer", err)
+	}
+	return nil
+}
+
+// newDeviceTemplate creates the workbook of the device import template
+func newDeviceTemplate(protocol string, profile *edgexDtos.DeviceProfile) (*excelize.File, errors.EdgeX) {
+	protocolProperties, ok := templateProtocols[protocol]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("unsupported protocol '%s', the supported protocols are %v", protocol, TemplateProtocols()), nil)
+	}
+
+	f := excelize.NewFile()
+	edgexErr := writeDeviceTemplate(f, protocol, protocolProperties, profile)
+	if edgexErr != nil {
+		_ = f.Close()
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return f, nil
+}
+
+func writeDeviceTemplate(f *excelize.File, protocol string, protocolProperties []templateProperty, profile *edgexDtos.DeviceProfile) errors.EdgeX {
+	var profileName string
+	var resourceNames, sourceNames []string
+	if profile != nil {
+		profileName = profile.Name
+		for _, resource := range profile.DeviceResources {
+			resourceNames = append(resourceNames, resource.Name)
+		}
+		sourceNames = slices.Clone(resourceNames)
+		for _, command := range profile.DeviceCommands {
+			sourceNames = append(sourceNames, command.Name)
+		}
+	}
+
+	// MappingTable sheet
+	mappingRows := [][]any{
+		{"Object", "Path", "Default Value"},
+		{"Name", "name"},
+		{description, "description"},
+		{"Labels", "labels"},
+		{adminState, "adminState", edgexModels.Unlocked},
+		{operatingState, "operatingState", edgexModels.Up},
+		{"ServiceName", "serviceName"},
+		{"ProfileName", "profileName", profileName},
+		{protocolName, strings.ToLower(properties) + mappingPathSeparator + common.ProtocolName, protocol},
+	}
+	devicesHeader := []any{"Name", description, "Labels", adminState, "ServiceName", "ProfileName"}
+	for _, property := range protocolProperties {
+		mappingRows = append(mappingRows, []any{property.object, strings.ToLower(protocols) + mappingPathSeparator + property.path, property.defaultValue})
+		devicesHeader = append(devicesHeader, property.object)
+	}
+	// the blank boolean cells of the AutoEvents and Schedules sheets are set to false instead of failing the parsing
+	mappingRows = append(mappingRows,
+		[]any{onChange, "schedules[].onChange", "false"},
+		[]any{publish, "schedules[].publish", "false"},
+		[]any{units, "schedules[].units", "false"},
+	)
+
+	autoEventsHeader := []any{"SourceName", "Interval", onChange, refDeviceName}
+	sheets := []templateSheet{
+		{devicesSheetName, [][]any{devicesHeader}},
+		{autoEventsSheetName, [][]any{autoEventsHeader}},
+		{schedulesSheetName, [][]any{defaultSchedulesHeader}},
+		{mappingTableSheetName, mappingRows},
+	}
+	// the source names are listed in the hidden sheet as the inline dropdown list is limited to 255 characters
+	if len(sourceNames) > 0 {
+		sourceRows := make([][]any, len(sourceNames))
+		for i, sourceName := range sourceNames {
+			sourceRows[i] = []any{sourceName}
+		}
+		sheets = append(sheets, templateSheet{sourceNamesSheetName, sourceRows})
+	}
+
+	// rename the default worksheet of the new workbook to keep the Devices sheet as the first and active sheet
+	err := f.SetSheetName(f.GetSheetName(0), devicesSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to add %s worksheet", devicesSheetName), err)
+	}
+	for _, sheet := range sheets {
+		if sheet.sheetName != devicesSheetName {
+			if _, err = f.NewSheet(sheet.sheetName); err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to add %s worksheet", sheet.sheetName), err)
+			}
+		}
+		for i, row := range sheet.rows {
+			err = f.SetSheetRow(sheet.sheetName, fmt.Sprintf("A%d", i+1), &row)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set row %d in the '%s' sheet", i+1, sheet.sheetName), err)
+			}
+		}
+	}
+	if len(sourceNames) > 0 {
+		if err = f.SetSheetVisible(sourceNamesSheetName, false); err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to hide %s worksheet", sourceNamesSheetName), err)
+		}
+	}
+
+	deviceNamesRange := fmt.Sprintf("%s!$A$2:$A$%d", devicesSheetName, templateRowCount+1)
+	boolOptions := []string{"true", "false"}
+	dropdowns := []templateDropdown{
+		{sheetName: devicesSheetName, header: devicesHeader, headerName: adminState, options: []string{edgexModels.Locked, edgexModels.Unlocked}, restricted: true},
+		{sheetName: autoEventsSheetName, header: autoEventsHeader, headerName: onChange, options: boolOptions, restricted: true},
+		{sheetName: autoEventsSheetName, header: autoEventsHeader, headerName: refDeviceName, sqref: deviceNamesRange},
+		{sheetName: schedulesSheetName, header: defaultSchedulesHeader, headerName: onChange, options: boolOptions, restricted: true},
+		{sheetName: schedulesSheetName, header: defaultSchedulesHeader, headerName: publish, options: boolOptions, restricted: true},
+		{sheetName: schedulesSheetName, header: defaultSchedulesHeader, headerName: units, options: boolOptions, restricted: true},
+		{sheetName: schedulesSheetName, header: defaultSchedulesHeader, headerName: refDeviceName, sqref: deviceNamesRange},
+	}
+	for _, property := range protocolProperties {
+		if len(property.options) > 0 {
+			dropdowns = append(dropdowns, templateDropdown{sheetName: devicesSheetName, header: devicesHeader, headerName: property.object,
+				options: property.options, restricted: true})
+		}
+	}
+	if profileName != "" {
+		dropdowns = append(dropdowns, templateDropdown{sheetName: devicesSheetName, header: devicesHeader, headerName: "ProfileName",
+			options: []string{profileName}})
+	}
+	if len(sourceNames) > 0 {
+		dropdowns = append(dropdowns, templateDropdown{sheetName: autoEventsSheetName, header: autoEventsHeader, headerName: "SourceName",
+			sqref: fmt.Sprintf("%s!$A$1:$A$%d", sourceNamesSheetName, len(sourceNames))})
+	}
+	if len(resourceNames) > 0 {
+		// the Resource cell may define several resources separated by commas, so the other values are allowed
+		dropdowns = append(dropdowns, templateDropdown{sheetName: schedulesSheetName, header: defaultSchedulesHeader, headerName: resource,
+			sqref: fmt.Sprintf("%s!$A$1:$A$%d", sourceNamesSheetName, len(resourceNames))})
+	}
+	for _, dropdown := range dropdowns {
+		if edgexErr := dropdown.add(f); edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	return nil
+}
+
+// templateSheet defines the rows of the template worksheet
+type templateSheet struct {
+	sheetName string
+	rows      [][]any
+}
+
+// templateDropdown defines the dropdown of the header column in the template worksheet, which offers either the inline
+// options or the cells referenced by sqref
+type templateDropdown struct {
+	sheetName  string
+	header     []any
+	headerName string
+	options    []string
+	sqref      string
+	restricted bool // whether the values other than the options are rejected
+}
+
+// add adds the dropdown to the data rows of the header column
+func (dropdown templateDropdown) add(f *excelize.File) errors.EdgeX {
+	colIndex := slices.Index(dropdown.header, any(dropdown.headerName))
+	if colIndex == -1 {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("column '%s' not defined in the header of %s worksheet", dropdown.headerName, dropdown.sheetName), nil)
+	}
+	columnName, err := excelize.ColumnNumberToName(colIndex + 1)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert column number %d to name", colIndex+1), err)
+	}
+
+	dv := excelize.NewDataValidation(true)
+	dv.Sqref = fmt.Sprintf("%s2:%s%d", columnName, columnName, templateRowCount+1)
+	if dropdown.sqref != "" {
+		dv.SetSqrefDropList(dropdown.sqref)
+	} else if err = dv.SetDropList(dropdown.options); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the dropdown of column '%s' in the '%s' sheet", dropdown.headerName, dropdown.sheetName), err)
+	}
+	if dropdown.restricted {
+		dv.SetError(excelize.DataValidationErrorStyleStop, "Invalid "+dropdown.headerName,
+			fmt.Sprintf("%s should be one of %s", dropdown.headerName, strings.Join(dropdown.options, ", ")))
+	}
+	err = f.AddDataValidation(dropdown.sheetName, dv)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to add the dropdown of column '%s' in the '%s' sheet", dropdown.headerName, dropdown.sheetName), err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xlsx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	edgexModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+var mockTemplateProfile = edgexDtos.DeviceProfile{
+	DeviceProfileBasicInfo: edgexDtos.DeviceProfileBasicInfo{Name: "bacnet_profile"},
+	DeviceResources: []edgexDtos.DeviceResource{
+		{Name: "analog_input_0:present-value"},
+		{Name: "analog_input_1:present-value"},
+	},
+	DeviceCommands: []edgexDtos.DeviceCommand{{Name: "analog_inputs"}},
+}
+
+// dataValidationsByRange returns the data validations of the worksheet keyed by the applied cell range
+func dataValidationsByRange(t *testing.T, f *excelize.File, sheetName string) map[string]*excelize.DataValidation {
+	dvs, err := f.GetDataValidations(sheetName)
+	require.NoError(t, err)
+	result := make(map[string]*excelize.DataValidation, len(dvs))
+	for _, dv := range dvs {
+		result[dv.Sqref] = dv
+	}
+	return result
+}
+
+func TestGenerateDeviceXlsxTemplate_UnsupportedProtocol(t *testing.T) {
+	var buffer bytes.Buffer
+	edgexErr := GenerateDeviceXlsxTemplate("unknown", nil, &buffer)
+	require.Error(t, edgexErr)
+	require.Zero(t, buffer.Len())
+}
+
+func TestGenerateDeviceXlsxTemplate_AllProtocols(t *testing.T) {
+	for _, protocol := range TemplateProtocols() {
+		t.Run(protocol, func(t *testing.T) {
+			var buffer bytes.Buffer
+			edgexErr := GenerateDeviceXlsxTemplate(protocol, nil, &buffer)
+			require.NoError(t, edgexErr)
+
+			f, err := excelize.OpenReader(&buffer)
+			require.NoError(t, err)
+			defer f.Close()
+			require.Equal(t, []string{devicesSheetName, autoEventsSheetName, schedulesSheetName, mappingTableSheetName}, f.GetSheetList())
+
+			fieldMappings, edgexErr := convertMappingTable(f)
+			require.NoError(t, edgexErr)
+			require.Equal(t, protocol, fieldMappings[protocolName].defaultValue)
+			header, err := f.GetRows(devicesSheetName)
+			require.NoError(t, err)
+			for _, property := range templateProtocols[protocol] {
+				require.Contains(t, header[0], property.object)
+				require.Equal(t, "protocols."+property.path, fieldMappings[property.object].path)
+			}
+		})
+	}
+}
+
+func TestGenerateDeviceXlsxTemplate_WithProfile(t *testing.T) {
+	var buffer bytes.Buffer
+	edgexErr := GenerateDeviceXlsxTemplate(common.BacnetIP, &mockTemplateProfile, &buffer)
+	require.NoError(t, edgexErr)
+
+	f, err := excelize.OpenReader(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	defer f.Close()
+
+	// the source names are listed in the hidden sheet
+	visible, err := f.GetSheetVisible(sourceNamesSheetName)
+	require.NoError(t, err)
+	require.False(t, visible)
+	sourceNames, err := f.GetRows(sourceNamesSheetName)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"analog_input_0:present-value"}, {"analog_input_1:present-value"}, {"analog_inputs"}}, sourceNames)
+
+	dvs := dataValidationsByRange(t, f, devicesSheetName)
+	require.Equal(t, `"LOCKED,UNLOCKED"`, dvs["D2:D1001"].Formula1)
+	require.True(t, dvs["D2:D1001"].ShowErrorMessage)
+	require.Equal(t, `"bacnet_profile"`, dvs["F2:F1001"].Formula1)
+	dvs = dataValidationsByRange(t, f, autoEventsSheetName)
+	require.Equal(t, "SourceNames!$A$1:$A$3", dvs["A2:A1001"].Formula1)
+	require.Equal(t, "Devices!$A$2:$A$1001", dvs["D2:D1001"].Formula1)
+	dvs = dataValidationsByRange(t, f, schedulesSheetName)
+	require.Equal(t, "SourceNames!$A$1:$A$2", dvs["B2:B1001"].Formula1)
+	require.False(t, dvs["B2:B1001"].ShowErrorMessage, "the comma separated resources should be allowed")
+
+	// fill the template and convert it to the devices
+	for sheetName, row := range map[string][]any{
+		devicesSheetName:    {"Sensor0001", "test BACnet/IP device 0001", "", "", "device-bacnet-ip", "", "1234", "192.168.64.4"},
+		autoEventsSheetName: {"analog_inputs", "10s", "false", "Sensor0001"},
+		schedulesSheetName:  {"schedule1", "analog_input_0:present-value", "1s", "true", "", "", "", "", "", "Sensor0001"},
+	} {
+		require.NoError(t, f.SetSheetRow(sheetName, "A2", &row))
+	}
+	filled, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	conv, edgexErr := ConvertDeviceXlsx(filled)
+	require.NoError(t, edgexErr)
+	require.Empty(t, conv.GetValidateErrors())
+	require.Len(t, conv.GetDTOs(), 1)
+	device := conv.GetDTOs()[0]
+	require.Equal(t, edgexModels.Unlocked, device.AdminState)
+	require.Equal(t, edgexModels.Up, device.OperatingState)
+	require.Equal(t, "bacnet_profile", device.ProfileName)
+	require.Equal(t, common.BacnetIP, device.Properties[common.ProtocolName])
+	require.Equal(t, edgexDtos.ProtocolProperties{
+		common.BacnetDeviceInstance: 1234,
+		common.BacnetAddress:        "192.168.64.4",
+		common.BacnetPort:           47808,
+	}, device.Protocols[common.BacnetIP])
+	require.Equal(t, []edgexDtos.AutoEvent{{Interval: "10s", SourceName: "analog_inputs"}}, device.AutoEvents)
+	schedules := conv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name)
+	require.Len(t, schedules, 1)
+	require.Equal(t, []string{"analog_input_0:present-value"}, schedules[0].Resource)
+
+	// the generated template can also be used to export the devices
+	var outputBuffer bytes.Buffer
+	edgexErr = ConvertDevicesToXlsx(bytes.NewReader(buffer.Bytes()), &outputBuffer, []edgexDtos.Device{*device},
+		map[string][]xrtmodels.Schedule{device.Name: schedules})
+	require.NoError(t, edgexErr)
+	reConv, edgexErr := ConvertDeviceXlsx(&outputBuffer)
+	require.NoError(t, edgexErr)
+	require.Equal(t, conv.GetDTOs(), reConv.GetDTOs())
+	require.Equal(t, schedules, reConv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name))
+}
+
+func TestGenerateDeviceXlsxTemplate_EnumeratedProperties(t *testing.T) {
+	f, edgexErr := newDeviceTemplate(common.ModbusRtu, nil)
+	require.NoError(t, edgexErr)
+	defer f.Close()
+
+	require.NotContains(t, f.GetSheetList(), sourceNamesSheetName)
+	dvs := dataValidationsByRange(t, f, devicesSheetName)
+	// Name, Description, Labels, AdminState, ServiceName, ProfileName, Address, BaudRate, DataBits, Parity
+	require.Equal(t, `"N,O,E"`, dvs["J2:J1001"].Formula1)
+	require.True(t, dvs["J2:J1001"].ShowErrorMessage)
+	require.NotContains(t, dvs, "F2:F1001", "no ProfileName dropdown without profile")
+}
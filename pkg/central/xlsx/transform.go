@@ -1,4 +1,4 @@
-// Copyright (C) 2023-2024 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
 )
 
 type mappingField struct {
@@ -16,10 +19,22 @@ type mappingField struct {
 }
 
 func ConvertDeviceXlsx(file io.Reader) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
-	deviceX, err := newDeviceXlsx(file)
+	return ConvertDeviceXlsxWithOptions(file, ImportOptions{})
+}
+
+// ConvertDeviceXlsxWithOptions converts the xlsx file to Device DTOs by the options, the problems found while parsing
+// the worksheets can be retrieved by the ImportReporter of the returned Converter
+func ConvertDeviceXlsxWithOptions(file io.Reader, options ImportOptions) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
+	return ConvertDeviceFile(FormatXlsx, file, options)
+}
+
+// convertDeviceWorkbook converts the worksheets of the opened workbook to Device DTOs by the options
+func convertDeviceWorkbook(f *excelize.File, options ImportOptions) (Converter[[]*edgexDtos.Device], errors.EdgeX) {
+	deviceX, err := newDeviceWorkbook(f)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create deviceXlsx instance", err)
 	}
+	deviceX.(*deviceXlsx).options = options
 
 	err = deviceX.ConvertToDTO()
 	if err != nil {
@@ -29,8 +44,33 @@ func ConvertDeviceXlsx(file io.Reader) (Converter[[]*edgexDtos.Device], errors.E
 	return deviceX, nil
 }
 
+// StreamDeviceXlsx parses the Devices sheet of the xlsx file row by row and passes each valid Device DTO with its
+// Schedules to the handler, so that the memory usage doesn't grow with the number of devices
+// It returns the problems found while parsing the worksheets, which include the devices failing the validation, the
+// problems of the AutoEvents and Schedules sheets are listed before the ones of the Devices sheet
+func StreamDeviceXlsx(file io.Reader, options ImportOptions, handler DeviceHandler) ([]ImportIssue, errors.EdgeX) {
+	deviceX, err := newDeviceXlsx(file)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create deviceXlsx instance", err)
+	}
+	dx := deviceX.(*deviceXlsx)
+	defer func() { _ = dx.xlsFile.Close() }()
+	dx.options = options
+
+	err = dx.streamDTOs(handler)
+	if err != nil {
+		return dx.importReport, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dx.importReport, nil
+}
+
 func ConvertDeviceProfileXlsx(file io.Reader) (Converter[*edgexDtos.DeviceProfile], error) {
-	deviceProfileX, err := newDeviceProfileXlsx(file)
+	return ConvertDeviceProfileFile(FormatXlsx, file)
+}
+
+// convertDeviceProfileWorkbook converts the worksheets of the opened workbook to DeviceProfile DTO
+func convertDeviceProfileWorkbook(f *excelize.File) (Converter[*edgexDtos.DeviceProfile], error) {
+	deviceProfileX, err := newDeviceProfileWorkbook(f)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create deviceProfileXlsx instance: %w", err)
 	}
@@ -45,13 +85,19 @@ func ConvertDeviceProfileXlsx(file io.Reader) (Converter[*edgexDtos.DeviceProfil
 
 // ConvertToXlsx converts the DTOs to the xlsx file and writes to io.Writer
 func ConvertToXlsx[T AllowedDTOConverterTypes](fileReader io.Reader, w io.Writer, convertData T) errors.EdgeX {
-	xlsxWriter, edgexErr := newXlsxWriter(convertData, fileReader)
-	if edgexErr != nil {
-		return edgexErr
-	}
+	return ConvertToFile(FormatXlsx, fileReader, w, convertData)
+}
+
+// ConvertDevicesToXlsx converts the Device DTOs and their XRT Schedules keyed by device name to the xlsx file and
+// writes to io.Writer, the Schedules can be read back by the DeviceScheduleReader of ConvertDeviceXlsx
+func ConvertDevicesToXlsx(fileReader io.Reader, w io.Writer, devices []edgexDtos.Device, schedules map[string][]xrtmodels.Schedule) errors.EdgeX {
+	return ConvertDevicesToFile(FormatXlsx, fileReader, w, devices, schedules)
+}
+
+func writeXlsx[T AllowedDTOConverterTypes](xlsxWriter DTOConverter[T], w io.Writer) errors.EdgeX {
 	defer func() { _ = xlsxWriter.closeXlsxFile() }()
 
-	edgexErr = xlsxWriter.ConvertToXlsx()
+	edgexErr := xlsxWriter.ConvertToXlsx()
 	if edgexErr != nil {
 		return edgexErr
 	}
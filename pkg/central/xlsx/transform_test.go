@@ -1,11 +1,14 @@
-// Copyright (C) 2023-2024 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 
 package xlsx
 
 import (
 	"bytes"
+	"os"
+	"slices"
 	"testing"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/xrtmodels"
 	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 
 	"github.com/stretchr/testify/require"
@@ -175,6 +178,83 @@ func Test_ConvertToDevicesXlsx_InvalidTemplate(t *testing.T) {
 	require.Error(t, edgexErr)
 }
 
+// newTestdataDevicesTemplate returns the devices xlsx testdata without the data rows as the template, and adds the
+// AutoEvents sheet with the header row since the testdata doesn't define it
+func newTestdataDevicesTemplate(t *testing.T, data []byte) []byte {
+	template, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer template.Close()
+	for _, sheetName := range []string{devicesSheetName, schedulesSheetName} {
+		rows, err := template.GetRows(sheetName)
+		require.NoError(t, err)
+		for range len(rows) - 1 {
+			require.NoError(t, template.RemoveRow(sheetName, 2))
+		}
+	}
+	// append the Options column, which the testdata Schedules sheet doesn't define
+	header, err := template.GetRows(schedulesSheetName)
+	require.NoError(t, err)
+	optionsCell, err := excelize.CoordinatesToCellName(len(header[0])+1, 1)
+	require.NoError(t, err)
+	require.NoError(t, template.SetCellValue(schedulesSheetName, optionsCell, scheduleOptions))
+	_, err = template.NewSheet(autoEventsSheetName)
+	require.NoError(t, err)
+	require.NoError(t, template.SetSheetRow(autoEventsSheetName, "A1", &[]any{"SourceName", "Interval", onChange, refDeviceName}))
+	buffer, err := template.WriteToBuffer()
+	require.NoError(t, err)
+	return buffer.Bytes()
+}
+
+func Test_ConvertDevicesToXlsx_RoundTrip(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+
+	// read the devices and schedules from the testdata
+	conv, edgexErr := ConvertDeviceXlsx(bytes.NewReader(data))
+	require.NoError(t, edgexErr)
+	devices := make([]edgexDtos.Device, 0, len(conv.GetDTOs()))
+	schedules := make(map[string][]xrtmodels.Schedule)
+	for _, device := range conv.GetDTOs() {
+		devices = append(devices, *device)
+		schedules[device.Name] = conv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name)
+	}
+	// the non-string options are written as JSON and decoded on read, the JSON numbers as float64
+	schedules["Sensor0002"] = slices.Clone(schedules["Sensor0002"])
+	schedules["Sensor0002"][0].Options = map[string]any{"priority": float64(1), "labels": []any{"a", "b"}}
+	require.NotEmpty(t, schedules["Sensor0002"])
+
+	// write them to the testdata template
+	var outputBuffer bytes.Buffer
+	edgexErr = ConvertDevicesToXlsx(bytes.NewReader(newTestdataDevicesTemplate(t, data)), &outputBuffer, devices, schedules)
+	require.NoError(t, edgexErr)
+
+	// read the written file again
+	reConv, edgexErr := ConvertDeviceXlsx(&outputBuffer)
+	require.NoError(t, edgexErr)
+	require.Equal(t, conv.GetDTOs(), reConv.GetDTOs())
+	for _, device := range reConv.GetDTOs() {
+		require.Equal(t, schedules[device.Name], reConv.(DeviceScheduleReader).GetSchedulesByDeviceName(device.Name))
+	}
+	require.Empty(t, reConv.GetValidateErrors())
+}
+
+func Test_ConvertDevicesToXlsx_NoAutoEventsSheet(t *testing.T) {
+	data, err := os.ReadFile("testdata/BACnet-IP_Device.xlsx")
+	require.NoError(t, err)
+	conv, edgexErr := ConvertDeviceXlsx(bytes.NewReader(data))
+	require.NoError(t, edgexErr)
+	devices := make([]edgexDtos.Device, 0, len(conv.GetDTOs()))
+	for _, device := range conv.GetDTOs() {
+		require.Empty(t, device.AutoEvents)
+		devices = append(devices, *device)
+	}
+
+	// the template must define the AutoEvents sheet even if no device defines the AutoEvents
+	var outputBuffer bytes.Buffer
+	edgexErr = ConvertDevicesToXlsx(bytes.NewReader(data), &outputBuffer, devices, nil)
+	require.Error(t, edgexErr)
+}
+
 func createXlsxTemplateFile() (*excelize.File, error) {
 	f, err := initialXlsxFile([]string{mappingTableSheetName, deviceInfoSheetName, deviceResourceSheetName, deviceCommandSheetName})
 	if err != nil {
@@ -6,24 +6,29 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"mime"
 	"net/url"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients"
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	edgexUtils "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	clientsInterfaces "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/clients/http/utils"
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/clients/interfaces"
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/dtos/responses"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobuf"
 )
 
 type TimeSeriesClient struct {
 	baseUrlFunc           clients.ClientBaseUrlFunc
 	authInjector          clientsInterfaces.AuthenticationInjector
 	enableNameFieldEscape bool
+	acceptProtobuf        bool
 }
 
 // NewTimeSeriesClient creates an instance of TimeSeriesClient
@@ -35,6 +40,17 @@ func NewTimeSeriesClient(baseUrl string, authInjector clientsInterfaces.Authenti
 	}
 }
 
+// NewTimeSeriesClientWithProtobuf creates an instance of TimeSeriesClient which requests the protobuf time series through the Accept header,
+// falling back to JSON if the service responds with JSON
+func NewTimeSeriesClientWithProtobuf(baseUrl string, authInjector clientsInterfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.TimeSeriesClient {
+	return &TimeSeriesClient{
+		baseUrlFunc:           clients.GetDefaultClientBaseUrlFunc(baseUrl),
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+		acceptProtobuf:        true,
+	}
+}
+
 func (tsc *TimeSeriesClient) TimeSeriesByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end int64) (responses.TimeSeriesResponse, errors.EdgeX) {
 	requestPath := edgexCommon.NewPathBuilder().EnableNameFieldEscape(tsc.enableNameFieldEscape).
 		SetPath(common.ApiTimeSeriesRoute).SetPath(edgexCommon.Device).SetPath(edgexCommon.Name).SetNameFieldPath(deviceName).SetPath(edgexCommon.ResourceName).SetNameFieldPath(resourceName).
@@ -45,11 +61,7 @@ func (tsc *TimeSeriesClient) TimeSeriesByDeviceNameAndResourceNameAndTimeRange(c
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
 	requestParams := url.Values{}
-	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, tsc.authInjector)
-	if err != nil {
-		return res, errors.NewCommonEdgeXWrapper(err)
-	}
-	return res, nil
+	return tsc.getTimeSeries(ctx, baseUrl, requestPath, requestParams)
 }
 
 func (tsc *TimeSeriesClient) TimeSeriesByDeviceNameAndMultiResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end int64) (responses.TimeSeriesResponse, errors.EdgeX) {
@@ -77,9 +89,42 @@ func (tsc *TimeSeriesClient) TimeSeriesByDeviceNameAndMultiResourceNamesAndTimeR
 	if base64Payload != "" {
 		requestParams.Set(common.Payload, base64Payload)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, tsc.authInjector)
+	return tsc.getTimeSeries(ctx, baseUrl, requestPath, requestParams)
+}
+
+func (tsc *TimeSeriesClient) getTimeSeries(ctx context.Context, baseUrl, requestPath string, requestParams url.Values) (responses.TimeSeriesResponse, errors.EdgeX) {
+	res := responses.TimeSeriesResponse{}
+	if !tsc.acceptProtobuf {
+		err := edgexUtils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, tsc.authInjector)
+		if err != nil {
+			return res, errors.NewCommonEdgeXWrapper(err)
+		}
+		return res, nil
+	}
+
+	data, contentType, edgexErr := utils.GetRequestAndReturnBinaryResWithHeaders(ctx, baseUrl, requestPath, requestParams, tsc.authInjector,
+		map[string]string{common.AcceptHeader: common.ContentTypeProtobuf})
+	if edgexErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return res, errors.NewCommonEdgeXWrapper(err)
+		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the response content type '%s'", contentType), err)
+	}
+	switch mediaType {
+	case common.ContentTypeProtobuf:
+		tsResources, err := protobuf.DecodeProtobufToTimeSeries(data)
+		if err != nil {
+			return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the protobuf time series response", err)
+		}
+		return responses.NewTimeSeriesResponse(tsResources), nil
+	case edgexCommon.ContentTypeJSON:
+		// the service responds with JSON if it doesn't support the protobuf time series
+		if err = json.Unmarshal(data, &res); err != nil {
+			return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the JSON time series response", err)
+		}
+		return res, nil
 	}
-	return res, nil
+	return res, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported response content type '%s'", contentType), nil)
 }
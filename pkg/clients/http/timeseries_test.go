@@ -5,15 +5,19 @@ package http
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/dtos"
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/dtos/responses"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobuf"
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 )
 
@@ -47,3 +51,75 @@ func TestQueryTimeSeriesByDeviceNameAndMultiResourceNamesAndTimeRange(t *testing
 	require.NoError(t, err)
 	assert.IsType(t, responses.TimeSeriesResponse{}, res)
 }
+
+func TestQueryTimeSeriesWithProtobuf(t *testing.T) {
+	deviceName := "device"
+	resourceName := "resource"
+	start := int64(1)
+	end := int64(10)
+	urlPath := path.Join(common.ApiTimeSeriesRoute, edgexCommon.Device, edgexCommon.Name, deviceName, edgexCommon.ResourceName, resourceName, edgexCommon.Start, strconv.FormatInt(start, 10), edgexCommon.End, strconv.FormatInt(end, 10))
+	tsResources := dtos.TimeSeriesResourceMap{
+		resourceName: {ValueType: edgexCommon.ValueTypeInt32, TimeSeries: [][]any{{int64(2), int32(20)}, {int64(1), int32(10)}}},
+	}
+	pbResponse, err := protobuf.ConvertTimeSeriesToProtobuf(tsResources)
+	require.NoError(t, err)
+	data, err := proto.Marshal(pbResponse)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != urlPath || r.Header.Get(common.AcceptHeader) != common.ContentTypeProtobuf {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set(edgexCommon.ContentType, common.ContentTypeProtobuf)
+		_, _ = w.Write(data)
+	}))
+	defer ts.Close()
+
+	client := NewTimeSeriesClientWithProtobuf(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.TimeSeriesByDeviceNameAndResourceNameAndTimeRange(context.Background(), deviceName, resourceName, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, responses.NewTimeSeriesResponse(tsResources), res)
+}
+
+// newContentTypeTestServer returns the test server which responds the data with the content type to the protobuf request
+func newContentTypeTestServer(urlPath, contentType string, data []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the request is created by the shared request builder which sets the correlation id
+		if r.URL.EscapedPath() != urlPath || r.Header.Get(common.AcceptHeader) != common.ContentTypeProtobuf ||
+			r.Header.Get(edgexCommon.CorrelationHeader) == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set(edgexCommon.ContentType, contentType)
+		_, _ = w.Write(data)
+	}))
+}
+
+func TestQueryTimeSeriesWithProtobuf_JSONFallback(t *testing.T) {
+	deviceName := "device"
+	start := int64(1)
+	end := int64(10)
+	urlPath := path.Join(common.ApiTimeSeriesRoute, edgexCommon.Device, edgexCommon.Name, deviceName, edgexCommon.Start, strconv.FormatInt(start, 10), edgexCommon.End, strconv.FormatInt(end, 10))
+	// the leading whitespace shouldn't matter since the response is detected by the content type
+	ts := newContentTypeTestServer(urlPath, edgexCommon.ContentTypeJSON+"; charset=utf-8", []byte(` {"apiVersion":"`+edgexCommon.ApiVersion+`"}`))
+	defer ts.Close()
+
+	client := NewTimeSeriesClientWithProtobuf(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.TimeSeriesByDeviceNameAndMultiResourceNamesAndTimeRange(context.Background(), deviceName, []string{"resource"}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, responses.TimeSeriesResponse{"apiVersion": edgexCommon.ApiVersion}, res)
+}
+
+func TestQueryTimeSeriesWithProtobuf_UnsupportedContentType(t *testing.T) {
+	deviceName := "device"
+	start := int64(1)
+	end := int64(10)
+	urlPath := path.Join(common.ApiTimeSeriesRoute, edgexCommon.Device, edgexCommon.Name, deviceName, edgexCommon.Start, strconv.FormatInt(start, 10), edgexCommon.End, strconv.FormatInt(end, 10))
+	ts := newContentTypeTestServer(urlPath, "text/plain", []byte(`{"apiVersion":"`+edgexCommon.ApiVersion+`"}`))
+	defer ts.Close()
+
+	client := NewTimeSeriesClientWithProtobuf(ts.URL, NewNullAuthenticationInjector(), false)
+	_, err := client.TimeSeriesByDeviceNameAndMultiResourceNamesAndTimeRange(context.Background(), deviceName, nil, start, end)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	edgexUtils "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// GetRequestAndReturnBinaryResWithHeaders makes the GET request with the headers by the request builder of
+// go-mod-core-contracts, and returns the response body with the response content type
+func GetRequestAndReturnBinaryResWithHeaders(ctx context.Context, baseUrl string, requestPath string, requestParams url.Values,
+	authInjector interfaces.AuthenticationInjector, headers map[string]string) ([]byte, string, errors.EdgeX) {
+	return edgexUtils.GetRequestAndReturnBinaryRes(ctx, baseUrl, requestPath, requestParams,
+		headersInjector{authInjector: authInjector, headers: headers})
+}
+
+// headersInjector sets the headers to the request before the authentication data is added, since the request builder of
+// go-mod-core-contracts doesn't accept the extra headers of the GET request
+type headersInjector struct {
+	authInjector interfaces.AuthenticationInjector
+	headers      map[string]string
+}
+
+func (h headersInjector) AddAuthenticationData(req *http.Request) error {
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	if h.authInjector == nil {
+		return nil
+	}
+	return h.authInjector.AddAuthenticationData(req)
+}
+
+func (h headersInjector) RoundTripper() http.RoundTripper {
+	if h.authInjector == nil {
+		return nil
+	}
+	return h.authInjector.RoundTripper()
+}
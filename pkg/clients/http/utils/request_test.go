@@ -0,0 +1,52 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenInjector struct{}
+
+func (tokenInjector) AddAuthenticationData(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer token")
+	return nil
+}
+
+func (tokenInjector) RoundTripper() http.RoundTripper {
+	return nil
+}
+
+func TestGetRequestAndReturnBinaryResWithHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/test" || r.URL.Query().Get("limit") != "1" ||
+			r.Header.Get("Accept") != "application/x-protobuf" ||
+			r.Header.Get("Authorization") != "Bearer token" ||
+			r.Header.Get(edgexCommon.CorrelationHeader) != "correlation-id" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set(edgexCommon.ContentType, "application/x-protobuf")
+		_, _ = w.Write([]byte{0x01})
+	}))
+	defer ts.Close()
+
+	ctx := context.WithValue(context.Background(), edgexCommon.CorrelationHeader, "correlation-id") // nolint:staticcheck
+	data, contentType, err := GetRequestAndReturnBinaryResWithHeaders(ctx, ts.URL, "/api/v3/test", url.Values{"limit": {"1"}},
+		tokenInjector{}, map[string]string{"Accept": "application/x-protobuf"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01}, data)
+	assert.Equal(t, "application/x-protobuf", contentType)
+
+	_, _, err = GetRequestAndReturnBinaryResWithHeaders(ctx, ts.URL, "/api/v3/test", nil, nil, nil)
+	require.Error(t, err)
+}
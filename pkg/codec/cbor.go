@@ -0,0 +1,43 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"reflect"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+type cborCodec struct {
+	decMode cbor.DecMode
+}
+
+// NewCBORCodec returns the Codec of the EdgeX CBOR Event
+// The integers of the object values are decoded exactly, the non-negative ones as uint64 and the negative ones as int64
+func NewCBORCodec() Codec {
+	// decode the maps of object values, tags and extensions as map[string]any like the JSON codec does
+	decMode, err := cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]any(nil))}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return cborCodec{decMode: decMode}
+}
+
+func (cborCodec) ContentType() string {
+	return common.ContentTypeCBOR
+}
+
+func (cborCodec) Encode(event dtos.Event) ([]byte, error) {
+	return cbor.Marshal(newNullReadingsEvent(event))
+}
+
+func (c cborCodec) Decode(data []byte) (*dtos.Event, error) {
+	var event nullReadingsEvent
+	if err := c.decMode.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	return event.toEvent()
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+	"sync"
+
+	pkgCommon "github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+const (
+	// ContentTypeMsgpack is the MIME type of the msgpack encoded Event
+	ContentTypeMsgpack = "application/msgpack"
+	// ContentTypeProtobuf is the MIME type of the protobuf encoded Event defined in pkg/protobuf/event.proto
+	ContentTypeProtobuf = pkgCommon.ContentTypeProtobuf
+)
+
+// Codec encodes and decodes the Event DTO for a single content type
+type Codec interface {
+	// ContentType returns the MIME type the codec is registered with
+	ContentType() string
+	// Encode encodes the Event to bytes
+	Encode(event dtos.Event) ([]byte, error)
+	// Decode decodes the bytes to an Event
+	Decode(data []byte) (*dtos.Event, error)
+}
+
+// Registry looks up the Codec by MIME type, ignoring the case and any parameters such as charset
+type Registry struct {
+	mutex  sync.RWMutex
+	codecs map[string]Codec
+}
+
+var defaultRegistry = NewRegistry(NewJSONCodec(), NewCBORCodec(), NewMsgpackCodec(), NewProtobufCodec())
+
+// NewRegistry returns a Registry with the specified codecs registered
+func NewRegistry(codecs ...Codec) *Registry {
+	r := &Registry{codecs: make(map[string]Codec, len(codecs))}
+	for _, c := range codecs {
+		r.Register(c)
+	}
+	return r
+}
+
+// DefaultRegistry returns the Registry with the JSON, CBOR, msgpack and protobuf codecs registered
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register registers the codec with its content type, replacing any codec previously registered with the same content type
+func (r *Registry) Register(codec Codec) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.codecs[mediaType(codec.ContentType())] = codec
+}
+
+// Get returns the codec registered with the content type
+func (r *Registry) Get(contentType string) (Codec, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	c, ok := r.codecs[mediaType(contentType)]
+	if !ok {
+		return nil, fmt.Errorf("no codec registered for content type '%s'", contentType)
+	}
+	return c, nil
+}
+
+// ContentTypes returns the content types of the registered codecs
+func (r *Registry) ContentTypes() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	contentTypes := make([]string, 0, len(r.codecs))
+	for contentType := range r.codecs {
+		contentTypes = append(contentTypes, contentType)
+	}
+	return contentTypes
+}
+
+// Encode encodes the Event with the codec registered with the content type
+func (r *Registry) Encode(contentType string, event dtos.Event) ([]byte, error) {
+	c, err := r.Get(contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Encode(event)
+}
+
+// Decode decodes the bytes to an Event with the codec registered with the content type
+func (r *Registry) Decode(contentType string, data []byte) (*dtos.Event, error) {
+	c, err := r.Get(contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decode(data)
+}
+
+// mediaType returns the lower-case media type of the content type without parameters
+func mediaType(contentType string) string {
+	if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mt
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
@@ -0,0 +1,341 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const roundTripIterations = 200
+
+// randomBits returns the random 64 bits, the boundary values of the integer types are returned more often, e.g.
+// 0 and all bits set, and the values with only the sign bit of each integer size unset or set
+func randomBits(r *rand.Rand) uint64 {
+	switch r.Intn(8) {
+	case 0:
+		return 0
+	case 1:
+		return math.MaxUint64
+	case 2:
+		// the maximum signed value of a random integer size
+		return math.MaxUint64 >> (64 - 8<<r.Intn(4) + 1)
+	case 3:
+		// the minimum signed value of a random integer size
+		return math.MaxUint64 << (8<<r.Intn(4) - 1)
+	default:
+		return r.Uint64()
+	}
+}
+
+var numericGenerators = map[string]func(r *rand.Rand) any{
+	common.ValueTypeBool:    func(r *rand.Rand) any { return r.Intn(2) == 1 },
+	common.ValueTypeInt8:    func(r *rand.Rand) any { return int8(randomBits(r)) },
+	common.ValueTypeInt16:   func(r *rand.Rand) any { return int16(randomBits(r)) },
+	common.ValueTypeInt32:   func(r *rand.Rand) any { return int32(randomBits(r)) },
+	common.ValueTypeInt64:   func(r *rand.Rand) any { return int64(randomBits(r)) },
+	common.ValueTypeUint8:   func(r *rand.Rand) any { return uint8(randomBits(r)) },
+	common.ValueTypeUint16:  func(r *rand.Rand) any { return uint16(randomBits(r)) },
+	common.ValueTypeUint32:  func(r *rand.Rand) any { return uint32(randomBits(r)) },
+	common.ValueTypeUint64:  func(r *rand.Rand) any { return randomBits(r) },
+	common.ValueTypeFloat32: func(r *rand.Rand) any { return float32(r.NormFloat64()) },
+	common.ValueTypeFloat64: func(r *rand.Rand) any { return r.NormFloat64() * 1e6 },
+	common.ValueTypeBoolArray: func(r *rand.Rand) any {
+		return randomSlice(r, func() bool { return r.Intn(2) == 1 })
+	},
+	common.ValueTypeInt8Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() int8 { return int8(randomBits(r)) })
+	},
+	common.ValueTypeInt16Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() int16 { return int16(randomBits(r)) })
+	},
+	common.ValueTypeInt32Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() int32 { return int32(randomBits(r)) })
+	},
+	common.ValueTypeInt64Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() int64 { return int64(randomBits(r)) })
+	},
+	common.ValueTypeUint8Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() uint8 { return uint8(randomBits(r)) })
+	},
+	common.ValueTypeUint16Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() uint16 { return uint16(randomBits(r)) })
+	},
+	common.ValueTypeUint32Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() uint32 { return uint32(randomBits(r)) })
+	},
+	common.ValueTypeUint64Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() uint64 { return randomBits(r) })
+	},
+	common.ValueTypeFloat32Array: func(r *rand.Rand) any {
+		return randomSlice(r, func() float32 { return float32(r.NormFloat64()) })
+	},
+	common.ValueTypeFloat64Array: func(r *rand.Rand) any {
+		return randomSlice(r, r.NormFloat64)
+	},
+}
+
+// numericValueTypes lists every numeric value type of numericGenerators in a fixed order for the seeded generation
+var numericValueTypes = slices.Sorted(maps.Keys(numericGenerators))
+
+func randomSlice[T any](r *rand.Rand, generate func() T) []T {
+	result := make([]T, 1+r.Intn(5))
+	for i := range result {
+		result[i] = generate()
+	}
+	return result
+}
+
+func randomString(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _-"
+	b := make([]byte, 1+r.Intn(16))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// randomObject returns a JSON object of strings, floats, bools and integers, including the 64-bit integers beyond
+// float64 precision, see decodedObjectValue for how each codec decodes the integers
+func randomObject(r *rand.Rand, depth int) map[string]any {
+	object := make(map[string]any)
+	for i := 0; i < 1+r.Intn(4); i++ {
+		key := fmt.Sprintf("key%d", i)
+		switch r.Intn(8) {
+		case 0:
+			object[key] = randomString(r)
+		case 1:
+			object[key] = r.NormFloat64()
+		case 2:
+			object[key] = r.Intn(2) == 1
+		case 3:
+			object[key] = []any{randomString(r), r.NormFloat64()}
+		case 4:
+			object[key] = int64(r.Intn(2001) - 1000)
+		case 5:
+			object[key] = int64(randomBits(r))
+		case 6:
+			object[key] = randomBits(r)
+		default:
+			if depth > 0 {
+				object[key] = randomObject(r, depth-1)
+			} else {
+				object[key] = randomString(r)
+			}
+		}
+	}
+	return object
+}
+
+// mapIntegers returns a copy of the object value with the int64 and uint64 values converted
+func mapIntegers(value any, convert func(any) any) any {
+	switch v := value.(type) {
+	case int64, uint64:
+		return convert(v)
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = mapIntegers(item, convert)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = mapIntegers(item, convert)
+		}
+		return result
+	}
+	return value
+}
+
+// isExactInFloat64 returns false if the object value holds an integer which float64 can't represent exactly
+func isExactInFloat64(value any) bool {
+	exact := true
+	mapIntegers(value, func(v any) any {
+		switch n := v.(type) {
+		case int64:
+			exact = exact && n >= -1<<53 && n <= 1<<53
+		case uint64:
+			exact = exact && n <= 1<<53
+		}
+		return v
+	})
+	return exact
+}
+
+func toFloat64(v any) any {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case uint64:
+		return float64(n)
+	}
+	return v
+}
+
+// decodedObjectValue returns the object value as decoded by the codec of the content type
+//   - JSON decodes every number as float64, losing the integer type and the precision beyond 2^53
+//   - protobuf stores the object in a structpb Struct, decoding every number as float64, unless it holds integers
+//     beyond float64 precision, in which case the object is stored as msgpack and decoded unchanged
+//   - CBOR keeps the integer values, but decodes the non-negative integers as uint64
+//   - msgpack decodes the int64 and uint64 values unchanged
+func decodedObjectValue(contentType string, value any) any {
+	switch contentType {
+	case common.ContentTypeJSON:
+		return mapIntegers(value, toFloat64)
+	case ContentTypeProtobuf:
+		if isExactInFloat64(value) {
+			return mapIntegers(value, toFloat64)
+		}
+	case common.ContentTypeCBOR:
+		return mapIntegers(value, func(v any) any {
+			if n, ok := v.(int64); ok && n >= 0 {
+				return uint64(n)
+			}
+			return v
+		})
+	}
+	return value
+}
+
+// decodedEvent returns a copy of the event with the object values as decoded by the codec of the content type
+func decodedEvent(contentType string, event dtos.Event) dtos.Event {
+	event.Readings = slices.Clone(event.Readings)
+	for i := range event.Readings {
+		if event.Readings[i].ObjectValue != nil {
+			event.Readings[i].ObjectValue = decodedObjectValue(contentType, event.Readings[i].ObjectValue)
+		}
+	}
+	return event
+}
+
+func randomReading(t *testing.T, r *rand.Rand, kind int) dtos.BaseReading {
+	const profileName, deviceName = "test-profile", "test-device"
+	resourceName := randomString(r)
+	var reading dtos.BaseReading
+	var err error
+	switch kind {
+	case 0: // Simple
+		if r.Intn(2) == 0 {
+			reading, err = dtos.NewSimpleReading(profileName, deviceName, resourceName, common.ValueTypeString, randomString(r))
+		} else {
+			reading, err = dtos.NewSimpleReading(profileName, deviceName, resourceName, common.ValueTypeInt32, r.Int31())
+		}
+		require.NoError(t, err)
+	case 1: // Numeric
+		valueType := numericValueTypes[r.Intn(len(numericValueTypes))]
+		reading, err = dtos.NewNumericReading(profileName, deviceName, resourceName, valueType, numericGenerators[valueType](r))
+		require.NoError(t, err)
+	case 2: // Binary
+		value := make([]byte, 1+r.Intn(32))
+		r.Read(value)
+		reading = dtos.NewBinaryReading(profileName, deviceName, resourceName, value, "application/octet-stream")
+	case 3: // Object
+		if r.Intn(2) == 0 {
+			reading = dtos.NewObjectReading(profileName, deviceName, resourceName, randomObject(r, 2))
+		} else {
+			reading = dtos.NewObjectReadingWithArray(profileName, deviceName, resourceName, []any{randomObject(r, 1), randomObject(r, 1)})
+		}
+	default: // Null
+		reading = dtos.NewNullReading(profileName, deviceName, resourceName, numericValueTypes[r.Intn(len(numericValueTypes))])
+	}
+	reading.Id = uuid.NewString()
+	reading.Origin = r.Int63()
+	if r.Intn(2) == 0 {
+		reading.Units = randomString(r)
+	}
+	return reading
+}
+
+func randomEvent(t *testing.T, r *rand.Rand) dtos.Event {
+	event := dtos.NewEvent("test-profile", "test-device", randomString(r))
+	event.Id = uuid.NewString()
+	event.Origin = r.Int63()
+	// every reading kind handled by FromReadingModelsToTimeSeriesResourceMap
+	for kind := 0; kind < 5; kind++ {
+		event.Readings = append(event.Readings, randomReading(t, r, kind))
+	}
+	r.Shuffle(len(event.Readings), func(i, j int) {
+		event.Readings[i], event.Readings[j] = event.Readings[j], event.Readings[i]
+	})
+	if r.Intn(2) == 0 {
+		event.Tags = dtos.Tags{"site": randomString(r)}
+	}
+	return event
+}
+
+// withoutEmptyMaps replaces the empty tags and extensions with nil, which encode the same
+func withoutEmptyMaps(event dtos.Event) dtos.Event {
+	if len(event.Tags) == 0 {
+		event.Tags = nil
+	}
+	if len(event.Extensions) == 0 {
+		event.Extensions = nil
+	}
+	for i := range event.Readings {
+		if len(event.Readings[i].Tags) == 0 {
+			event.Readings[i].Tags = nil
+		}
+		if len(event.Readings[i].Extensions) == 0 {
+			event.Readings[i].Extensions = nil
+		}
+	}
+	return event
+}
+
+func TestRoundTrip(t *testing.T) {
+	registry := DefaultRegistry()
+	contentTypes := []string{common.ContentTypeJSON, common.ContentTypeCBOR, ContentTypeMsgpack, ContentTypeProtobuf}
+	for _, contentType := range contentTypes {
+		t.Run(contentType, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1)) // #nosec G404
+			for i := 0; i < roundTripIterations; i++ {
+				event := randomEvent(t, r)
+				data, err := registry.Encode(contentType, event)
+				require.NoError(t, err)
+				result, err := registry.Decode(contentType, data)
+				require.NoError(t, err)
+				require.Equal(t, decodedEvent(contentType, event), withoutEmptyMaps(*result), "iteration %d", i)
+				for j, reading := range result.Readings {
+					require.Equal(t, event.Readings[j].IsNull(), reading.IsNull(), "iteration %d, reading %d", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(NewJSONCodec())
+
+	c, err := registry.Get("Application/JSON; charset=utf-8")
+	require.NoError(t, err)
+	assert.Equal(t, common.ContentTypeJSON, c.ContentType())
+
+	_, err = registry.Get(ContentTypeProtobuf)
+	require.Error(t, err)
+	_, err = registry.Decode(ContentTypeProtobuf, nil)
+	require.Error(t, err)
+
+	registry.Register(NewProtobufCodec())
+	assert.ElementsMatch(t, []string{common.ContentTypeJSON, ContentTypeProtobuf}, registry.ContentTypes())
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	for _, contentType := range DefaultRegistry().ContentTypes() {
+		t.Run(contentType, func(t *testing.T) {
+			_, err := DefaultRegistry().Decode(contentType, []byte{0xff, 0x01})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+type jsonCodec struct{}
+
+// NewJSONCodec returns the Codec of the EdgeX JSON Event
+// The integers of the object values are decoded as float64, losing the precision beyond 2^53
+func NewJSONCodec() Codec {
+	return jsonCodec{}
+}
+
+func (jsonCodec) ContentType() string {
+	return common.ContentTypeJSON
+}
+
+func (jsonCodec) Encode(event dtos.Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+func (jsonCodec) Decode(data []byte) (*dtos.Event, error) {
+	var event dtos.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	if err := decodeJSONNumericValues(data, &event); err != nil {
+		return nil, err
+	}
+	if err := normalizeNumericValues(&event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// decodeJSONNumericValues decodes the numeric values of the readings again as json.Number, since the float64 decoded
+// by json.Unmarshal loses the precision of the 64-bit integers
+func decodeJSONNumericValues(data []byte, event *dtos.Event) error {
+	if !slices.ContainsFunc(event.Readings, func(r dtos.BaseReading) bool { return r.NumericValue != nil }) {
+		return nil
+	}
+	var numericValues struct {
+		Readings []struct {
+			NumericValue any `json:"numericValue"`
+		} `json:"readings"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&numericValues); err != nil {
+		return err
+	}
+	for i, reading := range numericValues.Readings {
+		if i < len(event.Readings) && event.Readings[i].NumericValue != nil {
+			event.Readings[i].NumericValue = reading.NumericValue
+		}
+	}
+	return nil
+}
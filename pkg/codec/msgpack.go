@@ -0,0 +1,64 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"bytes"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type msgpackCodec struct{}
+
+// NewMsgpackCodec returns the Codec of the msgpack Event, which uses the same field names as the JSON Event
+// The int64 and uint64 values of the object values are decoded unchanged
+func NewMsgpackCodec() Codec {
+	return msgpackCodec{}
+}
+
+func (msgpackCodec) ContentType() string {
+	return ContentTypeMsgpack
+}
+
+func (msgpackCodec) Encode(event dtos.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := msgpack.NewEncoder(&buf)
+	encoder.SetCustomStructTag("json")
+	// copy the readings before wrapping the numeric values so the event of the caller is not modified
+	event.Readings = append([]dtos.BaseReading(nil), event.Readings...)
+	for i := range event.Readings {
+		if event.Readings[i].NumericValue != nil {
+			event.Readings[i].NumericValue = msgpackNumericValue{value: event.Readings[i].NumericValue}
+		}
+	}
+	if err := encoder.Encode(newNullReadingsEvent(event)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (msgpackCodec) Decode(data []byte) (*dtos.Event, error) {
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	decoder.SetCustomStructTag("json")
+	var event nullReadingsEvent
+	if err := decoder.Decode(&event); err != nil {
+		return nil, err
+	}
+	return event.toEvent()
+}
+
+// msgpackNumericValue prevents the omitempty option of the numeric value from dropping zero numbers and false,
+// since msgpack, unlike encoding/json, checks the value held by the interface for emptiness
+type msgpackNumericValue struct {
+	value any
+}
+
+func (v msgpackNumericValue) IsZero() bool {
+	return false
+}
+
+func (v msgpackNumericValue) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	return encoder.Encode(v.value)
+}
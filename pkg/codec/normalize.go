@@ -0,0 +1,141 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/spf13/cast"
+)
+
+// nullReadingsEvent extends the Event with the indexes of the null readings, since the null flag of a reading
+// is not exported and therefore dropped by the struct based msgpack and CBOR encoders
+type nullReadingsEvent struct {
+	dtos.Event
+	NullReadings []int `json:"nullReadings,omitempty"`
+}
+
+func newNullReadingsEvent(event dtos.Event) nullReadingsEvent {
+	result := nullReadingsEvent{Event: event}
+	for i, reading := range event.Readings {
+		if reading.IsNull() {
+			result.NullReadings = append(result.NullReadings, i)
+		}
+	}
+	return result
+}
+
+// toEvent restores the null readings and normalizes the decoded numeric values of the Event
+func (e nullReadingsEvent) toEvent() (*dtos.Event, error) {
+	event := e.Event
+	for _, i := range e.NullReadings {
+		if i < 0 || i >= len(event.Readings) {
+			return nil, fmt.Errorf("null reading index %d out of range", i)
+		}
+		reading := event.Readings[i]
+		nullReading := dtos.NewNullReading(reading.ProfileName, reading.DeviceName, reading.ResourceName, reading.ValueType)
+		nullReading.Id = reading.Id
+		nullReading.Origin = reading.Origin
+		nullReading.Units = reading.Units
+		nullReading.Tags = reading.Tags
+		nullReading.Extensions = reading.Extensions
+		event.Readings[i] = nullReading
+	}
+	if err := normalizeNumericValues(&event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// normalizeNumericValues converts the decoded numeric values, e.g. json.Number from JSON or []any from CBOR and msgpack,
+// back to the Go types of the reading value types
+func normalizeNumericValues(event *dtos.Event) error {
+	for i := range event.Readings {
+		reading := &event.Readings[i]
+		if reading.NumericValue == nil {
+			continue
+		}
+		value, err := normalizeNumericValue(reading.ValueType, reading.NumericValue)
+		if err != nil {
+			return fmt.Errorf("failed to decode the numeric value of reading '%s': %w", reading.ResourceName, err)
+		}
+		reading.NumericValue = value
+	}
+	return nil
+}
+
+func normalizeNumericValue(valueType string, value any) (any, error) {
+	switch valueType {
+	case common.ValueTypeBool:
+		return cast.ToBoolE(value)
+	case common.ValueTypeInt8:
+		return cast.ToInt8E(value)
+	case common.ValueTypeInt16:
+		return cast.ToInt16E(value)
+	case common.ValueTypeInt32:
+		return cast.ToInt32E(value)
+	case common.ValueTypeInt64:
+		return cast.ToInt64E(value)
+	case common.ValueTypeUint8:
+		return cast.ToUint8E(value)
+	case common.ValueTypeUint16:
+		return cast.ToUint16E(value)
+	case common.ValueTypeUint32:
+		return cast.ToUint32E(value)
+	case common.ValueTypeUint64:
+		return cast.ToUint64E(value)
+	case common.ValueTypeFloat32:
+		return cast.ToFloat32E(value)
+	case common.ValueTypeFloat64:
+		return cast.ToFloat64E(value)
+	case common.ValueTypeBoolArray:
+		return toSlice(value, cast.ToBoolE)
+	case common.ValueTypeInt8Array:
+		return toSlice(value, cast.ToInt8E)
+	case common.ValueTypeInt16Array:
+		return toSlice(value, cast.ToInt16E)
+	case common.ValueTypeInt32Array:
+		return toSlice(value, cast.ToInt32E)
+	case common.ValueTypeInt64Array:
+		return toSlice(value, cast.ToInt64E)
+	case common.ValueTypeUint8Array:
+		// []uint8 is encoded as the base64 string by encoding/json
+		if s, ok := value.(string); ok {
+			return base64.StdEncoding.DecodeString(s)
+		}
+		return toSlice(value, cast.ToUint8E)
+	case common.ValueTypeUint16Array:
+		return toSlice(value, cast.ToUint16E)
+	case common.ValueTypeUint32Array:
+		return toSlice(value, cast.ToUint32E)
+	case common.ValueTypeUint64Array:
+		return toSlice(value, cast.ToUint64E)
+	case common.ValueTypeFloat32Array:
+		return toSlice(value, cast.ToFloat32E)
+	case common.ValueTypeFloat64Array:
+		return toSlice(value, cast.ToFloat64E)
+	}
+	return value, nil
+}
+
+func toSlice[T any](value any, convert func(any) (T, error)) ([]T, error) {
+	switch v := value.(type) {
+	case []T:
+		return v, nil
+	case []any:
+		result := make([]T, len(v))
+		for i, item := range v {
+			converted, err := convert(item)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = converted
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unexpected array value type %T", value)
+}
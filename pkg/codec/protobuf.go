@@ -0,0 +1,35 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package codec
+
+import (
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobuf"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type protobufCodec struct{}
+
+// NewProtobufCodec returns the Codec of the protobuf Event defined in pkg/protobuf/event.proto
+// The integers of the object values are decoded as float64, unless the object holds integers beyond float64 precision
+func NewProtobufCodec() Codec {
+	return protobufCodec{}
+}
+
+func (protobufCodec) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (protobufCodec) Encode(event dtos.Event) ([]byte, error) {
+	pbEvent, err := protobuf.ConvertEventToProtobuf(event)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pbEvent)
+}
+
+func (protobufCodec) Decode(data []byte) (*dtos.Event, error) {
+	return protobuf.DecodeProtobufToEvent(data)
+}
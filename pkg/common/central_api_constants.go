@@ -82,4 +82,10 @@ const (
 	AuthorizationHeader   = "Authorization"
 	ForwardedUriHeader    = "X-Forwarded-Uri"
 	ForwardedMethodHeader = "X-Forwarded-Method"
+	AcceptHeader          = "Accept"
+)
+
+// constants relate to content types
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
 )
@@ -1,4 +1,4 @@
-// Copyright (C) 2022-2025 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 
 package common
 
@@ -109,6 +109,7 @@ const (
 	ModbusWriteMaxHoldingRegisters  = "WriteMaxHoldingRegisters"
 
 	Opcua                           = "OPC-UA"
+	OpcuaAddress                    = "Address"
 	OpcuaBrowseDepth                = "BrowseDepth"
 	OpcuaBrowsePublishInterval      = "BrowsePublishInterval"
 	OpcuaConnectionReadingPostDelay = "ConnectionReadingPostDelay"
@@ -119,9 +120,10 @@ const (
 	OpcuaSessionKeepAliveInterval   = "SessionKeepAliveInterval"
 	OpcuaWriteBatchSize             = "WriteBatchSize"
 
-	S7     = "S7"
-	S7Rack = "Rack"
-	S7Slot = "Slot"
+	S7        = "S7"
+	S7Address = "Address"
+	S7Rack    = "Rack"
+	S7Slot    = "Slot"
 
 	EtherNetIP                  = "ethernet-ip"
 	EtherNetIPXRT               = "EtherNet-IP" // XRT only accept EtherNet-IP as protocol name
@@ -142,6 +144,11 @@ const (
 	EtherNetIPMajorRevision     = "MajorRevision"
 	EtherNetIPMinorRevision     = "MinorRevision"
 	EtherNetIPAddress           = "Address"
+
+	Canbus         = "CANbus"
+	CanbusID       = "ID"
+	CanbusDataSize = "DataSize"
+	CanbusPort     = "Port"
 )
 
 // Constants related for proxy auth
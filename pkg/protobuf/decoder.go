@@ -0,0 +1,402 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"unsafe"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// protobuf field numbers of the Event message
+const (
+	eventApiVersionField  protowire.Number = 1
+	eventIdField          protowire.Number = 2
+	eventDeviceNameField  protowire.Number = 3
+	eventProfileNameField protowire.Number = 4
+	eventSourceNameField  protowire.Number = 5
+	eventOriginField      protowire.Number = 6
+	eventReadingsField    protowire.Number = 7
+	eventTagsField        protowire.Number = 8
+	eventExtensionsField  protowire.Number = 9
+)
+
+// protobuf field numbers of the Reading message
+const (
+	readingIdField               protowire.Number = 1
+	readingOriginField           protowire.Number = 2
+	readingDeviceNameField       protowire.Number = 3
+	readingResourceNameField     protowire.Number = 4
+	readingProfileNameField      protowire.Number = 5
+	readingValueTypeField        protowire.Number = 6
+	readingUnitsField            protowire.Number = 7
+	readingTagsField             protowire.Number = 8
+	readingValueField            protowire.Number = 9
+	readingBinaryValueField      protowire.Number = 10
+	readingMediaTypeField        protowire.Number = 11
+	readingObjectValueField      protowire.Number = 12
+	readingNumericValueField     protowire.Number = 13
+	readingIsNullField           protowire.Number = 14
+	readingExtensionsField       protowire.Number = 15
+	readingIntValueField         protowire.Number = 16
+	readingUintValueField        protowire.Number = 17
+	readingFloatValueField       protowire.Number = 18
+	readingDoubleValueField      protowire.Number = 19
+	readingBoolValueField        protowire.Number = 20
+	readingIntArrayValueField    protowire.Number = 21
+	readingUintArrayValueField   protowire.Number = 22
+	readingFloatArrayValueField  protowire.Number = 23
+	readingDoubleArrayValueField protowire.Number = 24
+	readingBoolArrayValueField   protowire.Number = 25
+	readingStructValueField      protowire.Number = 26
+	readingListValueField        protowire.Number = 27
+)
+
+var errNoDecodedEvent = errors.New("no event has been decoded")
+
+// EventDecoder decodes protobuf Events into a caller-supplied Event, reusing the readings slice of the Event between calls.
+// The strings and the binary values of the decoded Event reference the message bytes instead of copying them,
+// so the message bytes must not be modified while the decoded Event is in use.
+// The tags and extensions of the Event and its readings are left nil by Decode, and are JSON decoded into the Event
+// on the first call of Tags, Extensions, ReadingTags, ReadingExtensions or DecodeDeferred.
+// An EventDecoder is not safe for concurrent use.
+type EventDecoder struct {
+	event      *dtos.Event
+	tags       deferredMap
+	extensions deferredMap
+	readings   []deferredReading
+
+	// scratch holds the value fields of the reading being decoded, with the oneof wrappers and the string
+	// fields below reused between readings
+	scratch     Reading
+	value       string
+	mediaType   string
+	intValue    Reading_IntValue
+	uintValue   Reading_UintValue
+	floatValue  Reading_FloatValue
+	doubleValue Reading_DoubleValue
+	boolValue   Reading_BoolValue
+}
+
+// deferredMap holds the JSON bytes of a tags or extensions map until it is decoded
+type deferredMap struct {
+	raw     []byte
+	decoded bool
+}
+
+type deferredReading struct {
+	tags       deferredMap
+	extensions deferredMap
+}
+
+// NewEventDecoder returns an EventDecoder
+func NewEventDecoder() *EventDecoder {
+	return &EventDecoder{}
+}
+
+// Decode decodes the protobuf bytes into the Event, replacing its content and reusing the capacity of its readings slice
+func (d *EventDecoder) Decode(data []byte, event *dtos.Event) error {
+	readings := event.Readings[:0]
+	*event = dtos.Event{}
+	d.event = nil
+	d.tags = deferredMap{}
+	d.extensions = deferredMap{}
+	d.readings = d.readings[:0]
+
+	for len(data) > 0 {
+		field, n, err := consumeField(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+
+		switch {
+		case field.num == eventApiVersionField && field.typ == protowire.BytesType:
+			event.ApiVersion = unsafeString(field.bytes)
+		case field.num == eventIdField && field.typ == protowire.BytesType:
+			event.Id = unsafeString(field.bytes)
+		case field.num == eventDeviceNameField && field.typ == protowire.BytesType:
+			event.DeviceName = unsafeString(field.bytes)
+		case field.num == eventProfileNameField && field.typ == protowire.BytesType:
+			event.ProfileName = unsafeString(field.bytes)
+		case field.num == eventSourceNameField && field.typ == protowire.BytesType:
+			event.SourceName = unsafeString(field.bytes)
+		case field.num == eventOriginField && field.typ == protowire.VarintType:
+			event.Origin = int64(field.varint) // #nosec G115
+		case field.num == eventReadingsField && field.typ == protowire.BytesType:
+			readings = append(readings, dtos.BaseReading{})
+			d.readings = append(d.readings, deferredReading{})
+			if err = d.decodeReading(field.bytes, &readings[len(readings)-1], &d.readings[len(d.readings)-1]); err != nil {
+				return fmt.Errorf("failed to convert reading %d: %w", len(readings)-1, err)
+			}
+		case field.num == eventTagsField && field.typ == protowire.BytesType:
+			d.tags.raw = field.bytes
+		case field.num == eventExtensionsField && field.typ == protowire.BytesType:
+			d.extensions.raw = field.bytes
+		}
+	}
+
+	event.Readings = readings
+	d.event = event
+	return nil
+}
+
+func (d *EventDecoder) decodeReading(data []byte, reading *dtos.BaseReading, deferred *deferredReading) error {
+	pbReading := &d.scratch
+	proto.Reset(pbReading)
+	isNull := false
+
+	for len(data) > 0 {
+		field, n, err := consumeField(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+
+		switch field.typ {
+		case protowire.BytesType:
+			switch field.num {
+			case readingIdField:
+				reading.Id = unsafeString(field.bytes)
+			case readingDeviceNameField:
+				reading.DeviceName = unsafeString(field.bytes)
+			case readingResourceNameField:
+				reading.ResourceName = unsafeString(field.bytes)
+			case readingProfileNameField:
+				reading.ProfileName = unsafeString(field.bytes)
+			case readingValueTypeField:
+				reading.ValueType = unsafeString(field.bytes)
+				pbReading.ValueType = &reading.ValueType
+			case readingUnitsField:
+				reading.Units = unsafeString(field.bytes)
+			case readingTagsField:
+				deferred.tags.raw = field.bytes
+			case readingValueField:
+				d.value = unsafeString(field.bytes)
+				pbReading.Value = &d.value
+			case readingBinaryValueField:
+				pbReading.BinaryValue = field.bytes
+			case readingMediaTypeField:
+				d.mediaType = unsafeString(field.bytes)
+				pbReading.MediaType = &d.mediaType
+			case readingObjectValueField:
+				pbReading.ObjectValue = field.bytes
+			case readingNumericValueField:
+				pbReading.NumericValue = field.bytes
+			case readingExtensionsField:
+				deferred.extensions.raw = field.bytes
+			case readingIntArrayValueField:
+				values := &IntArray{}
+				pbReading.NativeValue = &Reading_IntArrayValue{IntArrayValue: values}
+				err = proto.Unmarshal(field.bytes, values)
+			case readingUintArrayValueField:
+				values := &UintArray{}
+				pbReading.NativeValue = &Reading_UintArrayValue{UintArrayValue: values}
+				err = proto.Unmarshal(field.bytes, values)
+			case readingFloatArrayValueField:
+				values := &FloatArray{}
+				pbReading.NativeValue = &Reading_FloatArrayValue{FloatArrayValue: values}
+				err = proto.Unmarshal(field.bytes, values)
+			case readingDoubleArrayValueField:
+				values := &DoubleArray{}
+				pbReading.NativeValue = &Reading_DoubleArrayValue{DoubleArrayValue: values}
+				err = proto.Unmarshal(field.bytes, values)
+			case readingBoolArrayValueField:
+				values := &BoolArray{}
+				pbReading.NativeValue = &Reading_BoolArrayValue{BoolArrayValue: values}
+				err = proto.Unmarshal(field.bytes, values)
+			case readingStructValueField:
+				value := &structpb.Struct{}
+				pbReading.NativeValue = &Reading_StructValue{StructValue: value}
+				err = proto.Unmarshal(field.bytes, value)
+			case readingListValueField:
+				value := &structpb.ListValue{}
+				pbReading.NativeValue = &Reading_ListValue{ListValue: value}
+				err = proto.Unmarshal(field.bytes, value)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal native value: %w", err)
+			}
+		case protowire.VarintType:
+			switch field.num {
+			case readingOriginField:
+				reading.Origin = int64(field.varint) // #nosec G115
+			case readingIsNullField:
+				isNull = field.varint != 0
+			case readingIntValueField:
+				d.intValue.IntValue = protowire.DecodeZigZag(field.varint)
+				pbReading.NativeValue = &d.intValue
+			case readingUintValueField:
+				d.uintValue.UintValue = field.varint
+				pbReading.NativeValue = &d.uintValue
+			case readingBoolValueField:
+				d.boolValue.BoolValue = field.varint != 0
+				pbReading.NativeValue = &d.boolValue
+			}
+		case protowire.Fixed32Type:
+			if field.num == readingFloatValueField {
+				d.floatValue.FloatValue = math.Float32frombits(uint32(field.varint)) // #nosec G115
+				pbReading.NativeValue = &d.floatValue
+			}
+		case protowire.Fixed64Type:
+			if field.num == readingDoubleValueField {
+				d.doubleValue.DoubleValue = math.Float64frombits(field.varint)
+				pbReading.NativeValue = &d.doubleValue
+			}
+		}
+	}
+
+	if isNull {
+		nullReading := dtos.NewNullReading(reading.ProfileName, reading.DeviceName, reading.ResourceName, reading.ValueType)
+		nullReading.Id = reading.Id
+		nullReading.Origin = reading.Origin
+		nullReading.Units = reading.Units
+		*reading = nullReading
+		return nil
+	}
+	return setReadingValue(reading, pbReading)
+}
+
+// Tags returns the tags of the decoded Event, decoding them into the Event on the first call
+// It returns nil if the Event has no tags
+func (d *EventDecoder) Tags() (dtos.Tags, error) {
+	if d.event == nil {
+		return nil, errNoDecodedEvent
+	}
+	if err := d.tags.decode((*map[string]any)(&d.event.Tags)); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
+	}
+	return d.event.Tags, nil
+}
+
+// Extensions returns the extensions of the decoded Event, decoding them into the Event on the first call
+// It returns nil if the Event has no extensions
+func (d *EventDecoder) Extensions() (map[string]any, error) {
+	if d.event == nil {
+		return nil, errNoDecodedEvent
+	}
+	if err := d.extensions.decode(&d.event.Extensions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal extensions: %w", err)
+	}
+	return d.event.Extensions, nil
+}
+
+// ReadingTags returns the tags of the reading at the index, decoding them into the reading on the first call
+// It returns nil if the reading has no tags
+func (d *EventDecoder) ReadingTags(index int) (dtos.Tags, error) {
+	reading, deferred, err := d.reading(index)
+	if err != nil {
+		return nil, err
+	}
+	if err = deferred.tags.decode((*map[string]any)(&reading.Tags)); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reading tags: %w", err)
+	}
+	return reading.Tags, nil
+}
+
+// ReadingExtensions returns the extensions of the reading at the index, decoding them into the reading on the first call
+// It returns nil if the reading has no extensions
+func (d *EventDecoder) ReadingExtensions(index int) (map[string]any, error) {
+	reading, deferred, err := d.reading(index)
+	if err != nil {
+		return nil, err
+	}
+	if err = deferred.extensions.decode(&reading.Extensions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reading extensions: %w", err)
+	}
+	return reading.Extensions, nil
+}
+
+// DecodeDeferred decodes all the tags and extensions of the decoded Event and its readings into the Event
+func (d *EventDecoder) DecodeDeferred() error {
+	if _, err := d.Tags(); err != nil {
+		return err
+	}
+	if _, err := d.Extensions(); err != nil {
+		return err
+	}
+	for i := range d.readings {
+		if _, err := d.ReadingTags(i); err != nil {
+			return err
+		}
+		if _, err := d.ReadingExtensions(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *EventDecoder) reading(index int) (*dtos.BaseReading, *deferredReading, error) {
+	if d.event == nil {
+		return nil, nil, errNoDecodedEvent
+	}
+	if index < 0 || index >= len(d.readings) || index >= len(d.event.Readings) {
+		return nil, nil, fmt.Errorf("reading index %d out of range", index)
+	}
+	return &d.event.Readings[index], &d.readings[index], nil
+}
+
+func (m *deferredMap) decode(target *map[string]any) error {
+	if m.decoded {
+		return nil
+	}
+	if len(m.raw) > 0 {
+		if err := json.Unmarshal(m.raw, target); err != nil {
+			return err
+		}
+	}
+	m.decoded = true
+	return nil
+}
+
+// wireField is a single field of a protobuf message, where varint holds the value of the varint, fixed32 and fixed64 fields
+type wireField struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+// consumeField parses the field at the start of the data and returns it with the number of bytes consumed
+func consumeField(data []byte) (wireField, int, error) {
+	num, typ, n := protowire.ConsumeTag(data)
+	if n < 0 {
+		return wireField{}, 0, protowire.ParseError(n)
+	}
+	field := wireField{num: num, typ: typ}
+	var m int
+	switch typ {
+	case protowire.VarintType:
+		field.varint, m = protowire.ConsumeVarint(data[n:])
+	case protowire.Fixed32Type:
+		var v uint32
+		v, m = protowire.ConsumeFixed32(data[n:])
+		field.varint = uint64(v)
+	case protowire.Fixed64Type:
+		field.varint, m = protowire.ConsumeFixed64(data[n:])
+	case protowire.BytesType:
+		field.bytes, m = protowire.ConsumeBytes(data[n:])
+	default:
+		m = protowire.ConsumeFieldValue(num, typ, data[n:])
+	}
+	if m < 0 {
+		return wireField{}, 0, protowire.ParseError(m)
+	}
+	return field, n + m, nil
+}
+
+// unsafeString returns the bytes as a string without copying them
+func unsafeString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(&b[0], len(b)) // #nosec G103
+}
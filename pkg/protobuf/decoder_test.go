@@ -0,0 +1,193 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestDecoderEvent(t testing.TB) dtos.Event {
+	event := dtos.NewEvent("test-profile", "test-device", "test-source")
+	event.Id = "6d1a4b8c-7c2e-4f7e-9f7e-2a1f0e9b1c3d"
+	event.Origin = 1700000000000000000
+	event.Tags = dtos.Tags{"site": "plant-1", "line": float64(4)}
+	event.Extensions = map[string]any{"trace": "abc"}
+
+	simple, err := dtos.NewSimpleReading("test-profile", "test-device", "status", common.ValueTypeString, "running")
+	require.NoError(t, err)
+	simple.Tags = dtos.Tags{"quality": "good"}
+	numeric, err := dtos.NewNumericReading("test-profile", "test-device", "temperature", common.ValueTypeFloat32, float32(21.5))
+	require.NoError(t, err)
+	numeric.Units = "degC"
+	integer, err := dtos.NewNumericReading("test-profile", "test-device", "count", common.ValueTypeInt16, int16(-7))
+	require.NoError(t, err)
+	array, err := dtos.NewNumericReading("test-profile", "test-device", "samples", common.ValueTypeUint8Array, []uint8{1, 2, 3})
+	require.NoError(t, err)
+	binary := dtos.NewBinaryReading("test-profile", "test-device", "image", []byte{0xde, 0xad}, "image/png")
+	object := dtos.NewObjectReading("test-profile", "test-device", "config", map[string]any{"mode": "auto"})
+	null := dtos.NewNullReading("test-profile", "test-device", "pressure", common.ValueTypeFloat64)
+	null.Extensions = map[string]any{"reason": "offline"}
+
+	event.Readings = []dtos.BaseReading{simple, numeric, integer, array, binary, object, null}
+	for i := range event.Readings {
+		event.Readings[i].Origin = event.Origin
+	}
+	return event
+}
+
+func marshalTestEvent(t testing.TB, event dtos.Event) []byte {
+	pbEvent, err := ConvertEventToProtobuf(event)
+	require.NoError(t, err)
+	data, err := proto.Marshal(pbEvent)
+	require.NoError(t, err)
+	return data
+}
+
+func TestEventDecoder(t *testing.T) {
+	data := marshalTestEvent(t, newTestDecoderEvent(t))
+	expected, err := DecodeProtobufToEvent(data)
+	require.NoError(t, err)
+
+	decoder := NewEventDecoder()
+	var event dtos.Event
+	require.NoError(t, decoder.Decode(data, &event))
+
+	// tags and extensions are decoded on access
+	assert.Nil(t, event.Tags)
+	assert.Nil(t, event.Readings[0].Tags)
+	tags, err := decoder.Tags()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Tags, tags)
+	assert.Equal(t, expected.Tags, event.Tags)
+	readingTags, err := decoder.ReadingTags(0)
+	require.NoError(t, err)
+	assert.Equal(t, dtos.Tags{"quality": "good"}, readingTags)
+	readingExtensions, err := decoder.ReadingExtensions(6)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"reason": "offline"}, readingExtensions)
+	readingTags, err = decoder.ReadingTags(1)
+	require.NoError(t, err)
+	assert.Nil(t, readingTags)
+	_, err = decoder.ReadingTags(7)
+	require.Error(t, err)
+
+	require.NoError(t, decoder.DecodeDeferred())
+	require.Len(t, event.Readings, len(expected.Readings))
+	for i, reading := range event.Readings {
+		expectedReading := expected.Readings[i]
+		if len(expectedReading.Tags) == 0 {
+			expectedReading.Tags = nil
+		}
+		if len(expectedReading.Extensions) == 0 {
+			expectedReading.Extensions = nil
+		}
+		assert.Equal(t, expectedReading, reading, "reading %d", i)
+		assert.Equal(t, expectedReading.IsNull(), reading.IsNull(), "reading %d", i)
+	}
+	event.Readings = expected.Readings
+	assert.Equal(t, *expected, event)
+}
+
+func TestEventDecoder_Reuse(t *testing.T) {
+	decoder := NewEventDecoder()
+	var event dtos.Event
+	require.NoError(t, decoder.Decode(marshalTestEvent(t, newTestDecoderEvent(t)), &event))
+	readings := event.Readings
+
+	// the Id of the reused event is reset if the second event doesn't define it
+	second := newTestEvent(t, 2)
+	second.Id = ""
+	require.NoError(t, decoder.Decode(marshalTestEvent(t, second), &event))
+	assert.Equal(t, second.DeviceName, event.DeviceName)
+	assert.Empty(t, event.Id)
+	require.Len(t, event.Readings, 1)
+	assert.Equal(t, "2", event.Readings[0].Value)
+	assert.Empty(t, event.Readings[0].Units)
+	assert.Same(t, &readings[0], &event.Readings[0])
+
+	tags, err := decoder.Tags()
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+}
+
+func TestEventDecoder_Invalid(t *testing.T) {
+	decoder := NewEventDecoder()
+	_, err := decoder.Tags()
+	require.Error(t, err)
+
+	var event dtos.Event
+	require.Error(t, decoder.Decode([]byte{0xff}, &event))
+	data := marshalTestEvent(t, newTestDecoderEvent(t))
+	require.Error(t, decoder.Decode(data[:len(data)-1], &event))
+
+	pbEvent := &Event{Tags: []byte("{")}
+	data, err = proto.Marshal(pbEvent)
+	require.NoError(t, err)
+	require.NoError(t, decoder.Decode(data, &event))
+	_, err = decoder.Tags()
+	require.Error(t, err)
+}
+
+func BenchmarkDecodeProtobufToEvent(b *testing.B) {
+	data := marshalTestEvent(b, newTestDecoderEvent(b))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeProtobufToEvent(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEventDecoder(b *testing.B) {
+	data := marshalTestEvent(b, newTestDecoderEvent(b))
+	decoder := NewEventDecoder()
+	var event dtos.Event
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := decoder.Decode(data, &event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEventDecoder_DecodeDeferred(b *testing.B) {
+	data := marshalTestEvent(b, newTestDecoderEvent(b))
+	decoder := NewEventDecoder()
+	var event dtos.Event
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := decoder.Decode(data, &event); err != nil {
+			b.Fatal(err)
+		}
+		if err := decoder.DecodeDeferred(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEventDecoder_SimpleReadings(b *testing.B) {
+	event := newTestEvent(b, 1)
+	for i := 0; i < 9; i++ {
+		event.Readings = append(event.Readings, event.Readings[0])
+	}
+	data := marshalTestEvent(b, event)
+	decoder := NewEventDecoder()
+	var decoded dtos.Event
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := decoder.Decode(data, &decoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
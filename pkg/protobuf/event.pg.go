@@ -13,6 +13,7 @@ package protobuf
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -45,7 +46,7 @@ type Event struct {
 	// Tags attached to the Event which give more context to the Event
 	// Entire tags map is stored as JSON-encoded bytes
 	Tags []byte `protobuf:"bytes,8,opt,name=tags,proto3,oneof" json:"tags,omitempty"`
-	// Extensions attached to the Event
+	// Additional metadata attached to the Event
 	// Entire extensions map is stored as JSON-encoded bytes
 	Extensions    []byte `protobuf:"bytes,9,opt,name=extensions,proto3,oneof" json:"extensions,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -145,6 +146,53 @@ func (x *Event) GetExtensions() []byte {
 	return nil
 }
 
+// EventBatch holds many Events encoded as a single message
+// Streams of Events use varint length-delimited framing of Event messages instead, see EventWriter and EventReader
+type EventBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Events in the batch
+	Events        []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventBatch) Reset() {
+	*x = EventBatch{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBatch) ProtoMessage() {}
+
+func (x *EventBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBatch.ProtoReflect.Descriptor instead.
+func (*EventBatch) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventBatch) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 // Reading represents a single reading within an Event
 type Reading struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -171,24 +219,43 @@ type Reading struct {
 	BinaryValue []byte `protobuf:"bytes,10,opt,name=binary_value,json=binaryValue,proto3,oneof" json:"binary_value,omitempty"`
 	// For BinaryReading: media type (e.g., MIME Type)
 	MediaType *string `protobuf:"bytes,11,opt,name=media_type,json=mediaType,proto3,oneof" json:"media_type,omitempty"`
-	// For ObjectReading: object value
-	// Entire object value is stored as MessagePack-encoded bytes
+	// For ObjectReading: legacy object value stored as MessagePack-encoded bytes
+	// Only written for object values which cannot be represented by struct_value or list_value,
+	// including objects holding integers beyond float64 precision (magnitude above 2^53)
 	ObjectValue []byte `protobuf:"bytes,12,opt,name=object_value,json=objectValue,proto3,oneof" json:"object_value,omitempty"`
-	// For NumericReading: numeric value
-	// Numeric value is stored as MessagePack-encoded bytes
+	// For NumericReading: legacy numeric value stored as MessagePack-encoded bytes
+	// Only written for numeric values which cannot be represented by the native typed values
 	NumericValue []byte `protobuf:"bytes,13,opt,name=numeric_value,json=numericValue,proto3,oneof" json:"numeric_value,omitempty"`
 	// Indicates if the reading value is null
 	IsNull *bool `protobuf:"varint,14,opt,name=is_null,json=isNull,proto3,oneof" json:"is_null,omitempty"`
-	// Extensions attached to the reading
+	// Additional metadata attached to the Reading
 	// Entire extensions map is stored as JSON-encoded bytes
-	Extensions    []byte `protobuf:"bytes,15,opt,name=extensions,proto3,oneof" json:"extensions,omitempty"`
+	Extensions []byte `protobuf:"bytes,15,opt,name=extensions,proto3,oneof" json:"extensions,omitempty"`
+	// Native typed value of a NumericReading or ObjectReading
+	// The exact Go type of a numeric value is restored from value_type, e.g. int_value is decoded as int8 for Int8
+	//
+	// Types that are valid to be assigned to NativeValue:
+	//
+	//	*Reading_IntValue
+	//	*Reading_UintValue
+	//	*Reading_FloatValue
+	//	*Reading_DoubleValue
+	//	*Reading_BoolValue
+	//	*Reading_IntArrayValue
+	//	*Reading_UintArrayValue
+	//	*Reading_FloatArrayValue
+	//	*Reading_DoubleArrayValue
+	//	*Reading_BoolArrayValue
+	//	*Reading_StructValue
+	//	*Reading_ListValue
+	NativeValue   isReading_NativeValue `protobuf_oneof:"native_value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Reading) Reset() {
 	*x = Reading{}
-	mi := &file_pkg_protobuf_event_proto_msgTypes[1]
+	mi := &file_pkg_protobuf_event_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +267,7 @@ func (x *Reading) String() string {
 func (*Reading) ProtoMessage() {}
 
 func (x *Reading) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protobuf_event_proto_msgTypes[1]
+	mi := &file_pkg_protobuf_event_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +280,7 @@ func (x *Reading) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Reading.ProtoReflect.Descriptor instead.
 func (*Reading) Descriptor() ([]byte, []int) {
-	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{1}
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Reading) GetId() string {
@@ -321,11 +388,441 @@ func (x *Reading) GetExtensions() []byte {
 	return nil
 }
 
+func (x *Reading) GetNativeValue() isReading_NativeValue {
+	if x != nil {
+		return x.NativeValue
+	}
+	return nil
+}
+
+func (x *Reading) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *Reading) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *Reading) GetFloatValue() float32 {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *Reading) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *Reading) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *Reading) GetIntArrayValue() *IntArray {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_IntArrayValue); ok {
+			return x.IntArrayValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetUintArrayValue() *UintArray {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_UintArrayValue); ok {
+			return x.UintArrayValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetFloatArrayValue() *FloatArray {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_FloatArrayValue); ok {
+			return x.FloatArrayValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetDoubleArrayValue() *DoubleArray {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_DoubleArrayValue); ok {
+			return x.DoubleArrayValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetBoolArrayValue() *BoolArray {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_BoolArrayValue); ok {
+			return x.BoolArrayValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetStructValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_StructValue); ok {
+			return x.StructValue
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetListValue() *structpb.ListValue {
+	if x != nil {
+		if x, ok := x.NativeValue.(*Reading_ListValue); ok {
+			return x.ListValue
+		}
+	}
+	return nil
+}
+
+type isReading_NativeValue interface {
+	isReading_NativeValue()
+}
+
+type Reading_IntValue struct {
+	// For NumericReading: Int8, Int16, Int32 and Int64 values
+	IntValue int64 `protobuf:"zigzag64,16,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type Reading_UintValue struct {
+	// For NumericReading: Uint8, Uint16, Uint32 and Uint64 values
+	UintValue uint64 `protobuf:"varint,17,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type Reading_FloatValue struct {
+	// For NumericReading: Float32 value
+	FloatValue float32 `protobuf:"fixed32,18,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type Reading_DoubleValue struct {
+	// For NumericReading: Float64 value
+	DoubleValue float64 `protobuf:"fixed64,19,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Reading_BoolValue struct {
+	// For NumericReading: Bool value
+	BoolValue bool `protobuf:"varint,20,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Reading_IntArrayValue struct {
+	// For NumericReading: Int8Array, Int16Array, Int32Array and Int64Array values
+	IntArrayValue *IntArray `protobuf:"bytes,21,opt,name=int_array_value,json=intArrayValue,proto3,oneof"`
+}
+
+type Reading_UintArrayValue struct {
+	// For NumericReading: Uint8Array, Uint16Array, Uint32Array and Uint64Array values
+	UintArrayValue *UintArray `protobuf:"bytes,22,opt,name=uint_array_value,json=uintArrayValue,proto3,oneof"`
+}
+
+type Reading_FloatArrayValue struct {
+	// For NumericReading: Float32Array value
+	FloatArrayValue *FloatArray `protobuf:"bytes,23,opt,name=float_array_value,json=floatArrayValue,proto3,oneof"`
+}
+
+type Reading_DoubleArrayValue struct {
+	// For NumericReading: Float64Array value
+	DoubleArrayValue *DoubleArray `protobuf:"bytes,24,opt,name=double_array_value,json=doubleArrayValue,proto3,oneof"`
+}
+
+type Reading_BoolArrayValue struct {
+	// For NumericReading: BoolArray value
+	BoolArrayValue *BoolArray `protobuf:"bytes,25,opt,name=bool_array_value,json=boolArrayValue,proto3,oneof"`
+}
+
+type Reading_StructValue struct {
+	// For ObjectReading: object value
+	// Numbers are stored as float64, so integer members are decoded as float64
+	StructValue *structpb.Struct `protobuf:"bytes,26,opt,name=struct_value,json=structValue,proto3,oneof"`
+}
+
+type Reading_ListValue struct {
+	// For ObjectReading: ObjectArray value
+	// Numbers are stored as float64, so integer members are decoded as float64
+	ListValue *structpb.ListValue `protobuf:"bytes,27,opt,name=list_value,json=listValue,proto3,oneof"`
+}
+
+func (*Reading_IntValue) isReading_NativeValue() {}
+
+func (*Reading_UintValue) isReading_NativeValue() {}
+
+func (*Reading_FloatValue) isReading_NativeValue() {}
+
+func (*Reading_DoubleValue) isReading_NativeValue() {}
+
+func (*Reading_BoolValue) isReading_NativeValue() {}
+
+func (*Reading_IntArrayValue) isReading_NativeValue() {}
+
+func (*Reading_UintArrayValue) isReading_NativeValue() {}
+
+func (*Reading_FloatArrayValue) isReading_NativeValue() {}
+
+func (*Reading_DoubleArrayValue) isReading_NativeValue() {}
+
+func (*Reading_BoolArrayValue) isReading_NativeValue() {}
+
+func (*Reading_StructValue) isReading_NativeValue() {}
+
+func (*Reading_ListValue) isReading_NativeValue() {}
+
+// IntArray holds the elements of a signed integer array value
+type IntArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []int64                `protobuf:"zigzag64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntArray) Reset() {
+	*x = IntArray{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntArray) ProtoMessage() {}
+
+func (x *IntArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntArray.ProtoReflect.Descriptor instead.
+func (*IntArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IntArray) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// UintArray holds the elements of an unsigned integer array value
+type UintArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []uint64               `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UintArray) Reset() {
+	*x = UintArray{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UintArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UintArray) ProtoMessage() {}
+
+func (x *UintArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UintArray.ProtoReflect.Descriptor instead.
+func (*UintArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UintArray) GetValues() []uint64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// FloatArray holds the elements of a Float32Array value
+type FloatArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float32              `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatArray) Reset() {
+	*x = FloatArray{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatArray) ProtoMessage() {}
+
+func (x *FloatArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatArray.ProtoReflect.Descriptor instead.
+func (*FloatArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FloatArray) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// DoubleArray holds the elements of a Float64Array value
+type DoubleArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleArray) Reset() {
+	*x = DoubleArray{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleArray) ProtoMessage() {}
+
+func (x *DoubleArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleArray.ProtoReflect.Descriptor instead.
+func (*DoubleArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DoubleArray) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// BoolArray holds the elements of a BoolArray value
+type BoolArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []bool                 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolArray) Reset() {
+	*x = BoolArray{}
+	mi := &file_pkg_protobuf_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolArray) ProtoMessage() {}
+
+func (x *BoolArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolArray.ProtoReflect.Descriptor instead.
+func (*BoolArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoolArray) GetValues() []bool {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_pkg_protobuf_event_proto protoreflect.FileDescriptor
 
 const file_pkg_protobuf_event_proto_rawDesc = "" +
 	"\n" +
-	"\x18pkg/protobuf/event.proto\x12\bprotobuf\"\xab\x03\n" +
+	"\x18pkg/protobuf/event.proto\x12\bprotobuf\x1a\x1cgoogle/protobuf/struct.proto\"\xab\x03\n" +
 	"\x05Event\x12$\n" +
 	"\vapi_version\x18\x01 \x01(\tH\x00R\n" +
 	"apiVersion\x88\x01\x01\x12\x13\n" +
@@ -348,30 +845,51 @@ const file_pkg_protobuf_event_proto_rawDesc = "" +
 	"\f_source_nameB\t\n" +
 	"\a_originB\a\n" +
 	"\x05_tagsB\r\n" +
-	"\v_extensions\"\xd6\x05\n" +
+	"\v_extensions\"5\n" +
+	"\n" +
+	"EventBatch\x12'\n" +
+	"\x06events\x18\x01 \x03(\v2\x0f.protobuf.EventR\x06events\"\xd5\n" +
+	"\n" +
 	"\aReading\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
-	"\x06origin\x18\x02 \x01(\x03H\x01R\x06origin\x88\x01\x01\x12$\n" +
-	"\vdevice_name\x18\x03 \x01(\tH\x02R\n" +
+	"\x02id\x18\x01 \x01(\tH\x01R\x02id\x88\x01\x01\x12\x1b\n" +
+	"\x06origin\x18\x02 \x01(\x03H\x02R\x06origin\x88\x01\x01\x12$\n" +
+	"\vdevice_name\x18\x03 \x01(\tH\x03R\n" +
 	"deviceName\x88\x01\x01\x12(\n" +
-	"\rresource_name\x18\x04 \x01(\tH\x03R\fresourceName\x88\x01\x01\x12&\n" +
-	"\fprofile_name\x18\x05 \x01(\tH\x04R\vprofileName\x88\x01\x01\x12\"\n" +
+	"\rresource_name\x18\x04 \x01(\tH\x04R\fresourceName\x88\x01\x01\x12&\n" +
+	"\fprofile_name\x18\x05 \x01(\tH\x05R\vprofileName\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"value_type\x18\x06 \x01(\tH\x05R\tvalueType\x88\x01\x01\x12\x19\n" +
-	"\x05units\x18\a \x01(\tH\x06R\x05units\x88\x01\x01\x12\x17\n" +
-	"\x04tags\x18\b \x01(\fH\aR\x04tags\x88\x01\x01\x12\x19\n" +
-	"\x05value\x18\t \x01(\tH\bR\x05value\x88\x01\x01\x12&\n" +
+	"value_type\x18\x06 \x01(\tH\x06R\tvalueType\x88\x01\x01\x12\x19\n" +
+	"\x05units\x18\a \x01(\tH\aR\x05units\x88\x01\x01\x12\x17\n" +
+	"\x04tags\x18\b \x01(\fH\bR\x04tags\x88\x01\x01\x12\x19\n" +
+	"\x05value\x18\t \x01(\tH\tR\x05value\x88\x01\x01\x12&\n" +
 	"\fbinary_value\x18\n" +
-	" \x01(\fH\tR\vbinaryValue\x88\x01\x01\x12\"\n" +
+	" \x01(\fH\n" +
+	"R\vbinaryValue\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"media_type\x18\v \x01(\tH\vR\tmediaType\x88\x01\x01\x12&\n" +
+	"\fobject_value\x18\f \x01(\fH\fR\vobjectValue\x88\x01\x01\x12(\n" +
+	"\rnumeric_value\x18\r \x01(\fH\rR\fnumericValue\x88\x01\x01\x12\x1c\n" +
+	"\ais_null\x18\x0e \x01(\bH\x0eR\x06isNull\x88\x01\x01\x12#\n" +
 	"\n" +
-	"media_type\x18\v \x01(\tH\n" +
-	"R\tmediaType\x88\x01\x01\x12&\n" +
-	"\fobject_value\x18\f \x01(\fH\vR\vobjectValue\x88\x01\x01\x12(\n" +
-	"\rnumeric_value\x18\r \x01(\fH\fR\fnumericValue\x88\x01\x01\x12\x1c\n" +
-	"\ais_null\x18\x0e \x01(\bH\rR\x06isNull\x88\x01\x01\x12#\n" +
+	"extensions\x18\x0f \x01(\fH\x0fR\n" +
+	"extensions\x88\x01\x01\x12\x1d\n" +
+	"\tint_value\x18\x10 \x01(\x12H\x00R\bintValue\x12\x1f\n" +
 	"\n" +
-	"extensions\x18\x0f \x01(\fH\x0eR\n" +
-	"extensions\x88\x01\x01B\x05\n" +
+	"uint_value\x18\x11 \x01(\x04H\x00R\tuintValue\x12!\n" +
+	"\vfloat_value\x18\x12 \x01(\x02H\x00R\n" +
+	"floatValue\x12#\n" +
+	"\fdouble_value\x18\x13 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x14 \x01(\bH\x00R\tboolValue\x12<\n" +
+	"\x0fint_array_value\x18\x15 \x01(\v2\x12.protobuf.IntArrayH\x00R\rintArrayValue\x12?\n" +
+	"\x10uint_array_value\x18\x16 \x01(\v2\x13.protobuf.UintArrayH\x00R\x0euintArrayValue\x12B\n" +
+	"\x11float_array_value\x18\x17 \x01(\v2\x14.protobuf.FloatArrayH\x00R\x0ffloatArrayValue\x12E\n" +
+	"\x12double_array_value\x18\x18 \x01(\v2\x15.protobuf.DoubleArrayH\x00R\x10doubleArrayValue\x12?\n" +
+	"\x10bool_array_value\x18\x19 \x01(\v2\x13.protobuf.BoolArrayH\x00R\x0eboolArrayValue\x12<\n" +
+	"\fstruct_value\x18\x1a \x01(\v2\x17.google.protobuf.StructH\x00R\vstructValue\x12;\n" +
+	"\n" +
+	"list_value\x18\x1b \x01(\v2\x1a.google.protobuf.ListValueH\x00R\tlistValueB\x0e\n" +
+	"\fnative_valueB\x05\n" +
 	"\x03_idB\t\n" +
 	"\a_originB\x0e\n" +
 	"\f_device_nameB\x10\n" +
@@ -387,7 +905,18 @@ const file_pkg_protobuf_event_proto_rawDesc = "" +
 	"\x0e_numeric_valueB\n" +
 	"\n" +
 	"\b_is_nullB\r\n" +
-	"\v_extensionsB=Z;github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobufb\x06proto3"
+	"\v_extensions\"\"\n" +
+	"\bIntArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x12R\x06values\"#\n" +
+	"\tUintArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x04R\x06values\"$\n" +
+	"\n" +
+	"FloatArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x02R\x06values\"%\n" +
+	"\vDoubleArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values\"#\n" +
+	"\tBoolArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\bR\x06valuesB=Z;github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobufb\x06proto3"
 
 var (
 	file_pkg_protobuf_event_proto_rawDescOnce sync.Once
@@ -401,18 +930,34 @@ func file_pkg_protobuf_event_proto_rawDescGZIP() []byte {
 	return file_pkg_protobuf_event_proto_rawDescData
 }
 
-var file_pkg_protobuf_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_protobuf_event_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pkg_protobuf_event_proto_goTypes = []any{
-	(*Event)(nil),   // 0: protobuf.Event
-	(*Reading)(nil), // 1: protobuf.Reading
+	(*Event)(nil),              // 0: protobuf.Event
+	(*EventBatch)(nil),         // 1: protobuf.EventBatch
+	(*Reading)(nil),            // 2: protobuf.Reading
+	(*IntArray)(nil),           // 3: protobuf.IntArray
+	(*UintArray)(nil),          // 4: protobuf.UintArray
+	(*FloatArray)(nil),         // 5: protobuf.FloatArray
+	(*DoubleArray)(nil),        // 6: protobuf.DoubleArray
+	(*BoolArray)(nil),          // 7: protobuf.BoolArray
+	(*structpb.Struct)(nil),    // 8: google.protobuf.Struct
+	(*structpb.ListValue)(nil), // 9: google.protobuf.ListValue
 }
 var file_pkg_protobuf_event_proto_depIdxs = []int32{
-	1, // 0: protobuf.Event.readings:type_name -> protobuf.Reading
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: protobuf.Event.readings:type_name -> protobuf.Reading
+	0, // 1: protobuf.EventBatch.events:type_name -> protobuf.Event
+	3, // 2: protobuf.Reading.int_array_value:type_name -> protobuf.IntArray
+	4, // 3: protobuf.Reading.uint_array_value:type_name -> protobuf.UintArray
+	5, // 4: protobuf.Reading.float_array_value:type_name -> protobuf.FloatArray
+	6, // 5: protobuf.Reading.double_array_value:type_name -> protobuf.DoubleArray
+	7, // 6: protobuf.Reading.bool_array_value:type_name -> protobuf.BoolArray
+	8, // 7: protobuf.Reading.struct_value:type_name -> google.protobuf.Struct
+	9, // 8: protobuf.Reading.list_value:type_name -> google.protobuf.ListValue
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protobuf_event_proto_init() }
@@ -421,14 +966,27 @@ func file_pkg_protobuf_event_proto_init() {
 		return
 	}
 	file_pkg_protobuf_event_proto_msgTypes[0].OneofWrappers = []any{}
-	file_pkg_protobuf_event_proto_msgTypes[1].OneofWrappers = []any{}
+	file_pkg_protobuf_event_proto_msgTypes[2].OneofWrappers = []any{
+		(*Reading_IntValue)(nil),
+		(*Reading_UintValue)(nil),
+		(*Reading_FloatValue)(nil),
+		(*Reading_DoubleValue)(nil),
+		(*Reading_BoolValue)(nil),
+		(*Reading_IntArrayValue)(nil),
+		(*Reading_UintArrayValue)(nil),
+		(*Reading_FloatArrayValue)(nil),
+		(*Reading_DoubleArrayValue)(nil),
+		(*Reading_BoolArrayValue)(nil),
+		(*Reading_StructValue)(nil),
+		(*Reading_ListValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protobuf_event_proto_rawDesc), len(file_pkg_protobuf_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,287 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// setNativeNumericValue sets the native_value oneof of the protobuf Reading from the numeric value
+// It returns false if the value has no native representation and must be stored in the legacy numeric_value
+func setNativeNumericValue(pbReading *Reading, value any) bool {
+	switch v := value.(type) {
+	case int:
+		pbReading.NativeValue = &Reading_IntValue{IntValue: int64(v)}
+	case int8:
+		pbReading.NativeValue = &Reading_IntValue{IntValue: int64(v)}
+	case int16:
+		pbReading.NativeValue = &Reading_IntValue{IntValue: int64(v)}
+	case int32:
+		pbReading.NativeValue = &Reading_IntValue{IntValue: int64(v)}
+	case int64:
+		pbReading.NativeValue = &Reading_IntValue{IntValue: v}
+	case uint:
+		pbReading.NativeValue = &Reading_UintValue{UintValue: uint64(v)}
+	case uint8:
+		pbReading.NativeValue = &Reading_UintValue{UintValue: uint64(v)}
+	case uint16:
+		pbReading.NativeValue = &Reading_UintValue{UintValue: uint64(v)}
+	case uint32:
+		pbReading.NativeValue = &Reading_UintValue{UintValue: uint64(v)}
+	case uint64:
+		pbReading.NativeValue = &Reading_UintValue{UintValue: v}
+	case float32:
+		pbReading.NativeValue = &Reading_FloatValue{FloatValue: v}
+	case float64:
+		pbReading.NativeValue = &Reading_DoubleValue{DoubleValue: v}
+	case bool:
+		pbReading.NativeValue = &Reading_BoolValue{BoolValue: v}
+	case []int8:
+		pbReading.NativeValue = &Reading_IntArrayValue{IntArrayValue: &IntArray{Values: toInt64s(v)}}
+	case []int16:
+		pbReading.NativeValue = &Reading_IntArrayValue{IntArrayValue: &IntArray{Values: toInt64s(v)}}
+	case []int32:
+		pbReading.NativeValue = &Reading_IntArrayValue{IntArrayValue: &IntArray{Values: toInt64s(v)}}
+	case []int64:
+		pbReading.NativeValue = &Reading_IntArrayValue{IntArrayValue: &IntArray{Values: v}}
+	case []uint8:
+		pbReading.NativeValue = &Reading_UintArrayValue{UintArrayValue: &UintArray{Values: toUint64s(v)}}
+	case []uint16:
+		pbReading.NativeValue = &Reading_UintArrayValue{UintArrayValue: &UintArray{Values: toUint64s(v)}}
+	case []uint32:
+		pbReading.NativeValue = &Reading_UintArrayValue{UintArrayValue: &UintArray{Values: toUint64s(v)}}
+	case []uint64:
+		pbReading.NativeValue = &Reading_UintArrayValue{UintArrayValue: &UintArray{Values: v}}
+	case []float32:
+		pbReading.NativeValue = &Reading_FloatArrayValue{FloatArrayValue: &FloatArray{Values: v}}
+	case []float64:
+		pbReading.NativeValue = &Reading_DoubleArrayValue{DoubleArrayValue: &DoubleArray{Values: v}}
+	case []bool:
+		pbReading.NativeValue = &Reading_BoolArrayValue{BoolArrayValue: &BoolArray{Values: v}}
+	default:
+		return false
+	}
+	return true
+}
+
+// nativeNumericValue returns the numeric value of the native_value oneof as the Go type of the value type
+// It returns false if the protobuf Reading carries no native numeric value
+func nativeNumericValue(pbReading *Reading) (any, bool) {
+	valueType := pbReading.GetValueType()
+	switch v := pbReading.GetNativeValue().(type) {
+	case *Reading_IntValue:
+		return intValue(valueType, v.IntValue), true
+	case *Reading_UintValue:
+		return uintValue(valueType, v.UintValue), true
+	case *Reading_FloatValue:
+		return v.FloatValue, true
+	case *Reading_DoubleValue:
+		return v.DoubleValue, true
+	case *Reading_BoolValue:
+		return v.BoolValue, true
+	case *Reading_IntArrayValue:
+		values := v.IntArrayValue.GetValues()
+		switch valueType {
+		case common.ValueTypeInt8Array:
+			return fromInt64s[int8](values), true
+		case common.ValueTypeInt16Array:
+			return fromInt64s[int16](values), true
+		case common.ValueTypeInt32Array:
+			return fromInt64s[int32](values), true
+		}
+		return nonNil(values), true
+	case *Reading_UintArrayValue:
+		values := v.UintArrayValue.GetValues()
+		switch valueType {
+		case common.ValueTypeUint8Array:
+			return fromUint64s[uint8](values), true
+		case common.ValueTypeUint16Array:
+			return fromUint64s[uint16](values), true
+		case common.ValueTypeUint32Array:
+			return fromUint64s[uint32](values), true
+		}
+		return nonNil(values), true
+	case *Reading_FloatArrayValue:
+		return nonNil(v.FloatArrayValue.GetValues()), true
+	case *Reading_DoubleArrayValue:
+		return nonNil(v.DoubleArrayValue.GetValues()), true
+	case *Reading_BoolArrayValue:
+		return nonNil(v.BoolArrayValue.GetValues()), true
+	}
+	return nil, false
+}
+
+// setNativeObjectValue sets the native_value oneof of the protobuf Reading from the object value
+// It returns false if the value is neither a JSON object nor a JSON array, or holds integers beyond float64 precision,
+// and must be stored in the legacy object_value
+func setNativeObjectValue(pbReading *Reading, value any) bool {
+	pbValue, err := newStructValue(value)
+	if err != nil {
+		return false
+	}
+	switch kind := pbValue.GetKind().(type) {
+	case *structpb.Value_StructValue:
+		pbReading.NativeValue = &Reading_StructValue{StructValue: kind.StructValue}
+	case *structpb.Value_ListValue:
+		pbReading.NativeValue = &Reading_ListValue{ListValue: kind.ListValue}
+	default:
+		return false
+	}
+	return true
+}
+
+// nativeObjectValue returns the object value of the native_value oneof
+// It returns false if the protobuf Reading carries no native object value
+func nativeObjectValue(pbReading *Reading) (any, bool) {
+	switch v := pbReading.GetNativeValue().(type) {
+	case *Reading_StructValue:
+		return v.StructValue.AsMap(), true
+	case *Reading_ListValue:
+		return v.ListValue.AsSlice(), true
+	}
+	return nil, false
+}
+
+// maxExactFloat64Integer is the largest magnitude below which float64 represents every integer exactly
+const maxExactFloat64Integer = 1 << 53
+
+var errInexactInteger = errors.New("object value holds integers which float64 can't represent exactly")
+
+// newStructValue converts the value to a structpb Value
+// Values with Go types unknown to structpb, e.g. []int32 or structs, are normalized through JSON
+// structpb stores all numbers as float64, so values holding integers beyond float64 precision are rejected
+func newStructValue(value any) (*structpb.Value, error) {
+	if !isExactInFloat64(value) {
+		return nil, errInexactInteger
+	}
+	pbValue, err := structpb.NewValue(value)
+	if err == nil {
+		return pbValue, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&normalized); err != nil {
+		return nil, err
+	}
+	if !isExactInFloat64(normalized) {
+		return nil, errInexactInteger
+	}
+	return structpb.NewValue(normalized)
+}
+
+// isExactInFloat64 returns false if the value holds an integer which float64 can't represent exactly
+func isExactInFloat64(value any) bool {
+	switch v := value.(type) {
+	case int:
+		return v >= -maxExactFloat64Integer && v <= maxExactFloat64Integer
+	case int64:
+		return v >= -maxExactFloat64Integer && v <= maxExactFloat64Integer
+	case uint:
+		return v <= maxExactFloat64Integer
+	case uint64:
+		return v <= maxExactFloat64Integer
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return true
+		}
+		if i, err := v.Int64(); err == nil {
+			return isExactInFloat64(i)
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return isExactInFloat64(u)
+		}
+		return false
+	case map[string]any:
+		for _, item := range v {
+			if !isExactInFloat64(item) {
+				return false
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if !isExactInFloat64(item) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// intValue returns the signed integer as the Go type of the value type
+func intValue(valueType string, value int64) any {
+	switch valueType {
+	case common.ValueTypeInt8:
+		return int8(value) // #nosec G115
+	case common.ValueTypeInt16:
+		return int16(value) // #nosec G115
+	case common.ValueTypeInt32:
+		return int32(value) // #nosec G115
+	}
+	return value
+}
+
+// uintValue returns the unsigned integer as the Go type of the value type
+func uintValue(valueType string, value uint64) any {
+	switch valueType {
+	case common.ValueTypeUint8:
+		return uint8(value) // #nosec G115
+	case common.ValueTypeUint16:
+		return uint16(value) // #nosec G115
+	case common.ValueTypeUint32:
+		return uint32(value) // #nosec G115
+	}
+	return value
+}
+
+func toInt64s[T int8 | int16 | int32](values []T) []int64 {
+	result := make([]int64, len(values))
+	for i, v := range values {
+		result[i] = int64(v)
+	}
+	return result
+}
+
+func toUint64s[T uint8 | uint16 | uint32](values []T) []uint64 {
+	result := make([]uint64, len(values))
+	for i, v := range values {
+		result[i] = uint64(v)
+	}
+	return result
+}
+
+func fromInt64s[T int8 | int16 | int32](values []int64) []T {
+	result := make([]T, len(values))
+	for i, v := range values {
+		result[i] = T(v)
+	}
+	return result
+}
+
+func fromUint64s[T uint8 | uint16 | uint32](values []uint64) []T {
+	result := make([]T, len(values))
+	for i, v := range values {
+		result[i] = T(v)
+	}
+	return result
+}
+
+// nonNil returns an empty slice instead of nil, since an empty repeated field is decoded as nil
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMaxEventSize is the default maximum size in bytes of a single Event read by EventReader
+const DefaultMaxEventSize = 64 * 1024 * 1024
+
+// ConvertEventsToProtobufBatch converts the dtos.Events to a protobuf EventBatch
+func ConvertEventsToProtobufBatch(events []dtos.Event) (*EventBatch, error) {
+	batch := &EventBatch{Events: make([]*Event, len(events))}
+	for i, event := range events {
+		pbEvent, err := ConvertEventToProtobuf(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event %d: %w", i, err)
+		}
+		batch.Events[i] = pbEvent
+	}
+	return batch, nil
+}
+
+// DecodeProtobufBatchToEvents decodes protobuf EventBatch bytes back to EdgeX Events
+func DecodeProtobufBatchToEvents(data []byte) ([]dtos.Event, error) {
+	var batch EventBatch
+	if err := proto.Unmarshal(data, &batch); err != nil {
+		return nil, err
+	}
+
+	events := make([]dtos.Event, len(batch.GetEvents()))
+	for i, pbEvent := range batch.GetEvents() {
+		event, err := convertProtobufToEvent(pbEvent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event %d: %w", i, err)
+		}
+		events[i] = *event
+	}
+	return events, nil
+}
+
+// EventWriter writes a stream of protobuf Events to io.Writer, each prefixed with its varint encoded length
+type EventWriter struct {
+	w io.Writer
+}
+
+// NewEventWriter creates an EventWriter writing to w
+func NewEventWriter(w io.Writer) *EventWriter {
+	return &EventWriter{w: w}
+}
+
+// Write encodes the event and writes it as one length-delimited message
+func (ew *EventWriter) Write(event dtos.Event) error {
+	pbEvent, err := ConvertEventToProtobuf(event)
+	if err != nil {
+		return err
+	}
+	if _, err = protodelim.MarshalTo(ew.w, pbEvent); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	return nil
+}
+
+// EventReader reads a stream of length-delimited protobuf Events written by EventWriter from io.Reader
+type EventReader struct {
+	r       *bufio.Reader
+	options protodelim.UnmarshalOptions
+}
+
+// NewEventReader creates an EventReader reading from r with DefaultMaxEventSize as the maximum Event size
+func NewEventReader(r io.Reader) *EventReader {
+	return NewEventReaderWithMaxSize(r, DefaultMaxEventSize)
+}
+
+// NewEventReaderWithMaxSize creates an EventReader reading from r which rejects Events larger than maxSize bytes
+func NewEventReaderWithMaxSize(r io.Reader, maxSize int64) *EventReader {
+	return &EventReader{
+		r:       bufio.NewReader(r),
+		options: protodelim.UnmarshalOptions{MaxSize: maxSize},
+	}
+}
+
+// Read reads and decodes the next Event of the stream
+// It returns io.EOF when the stream ends cleanly between two Events and io.ErrUnexpectedEOF when it ends within an Event
+func (er *EventReader) Read() (*dtos.Event, error) {
+	var pbEvent Event
+	if err := er.options.UnmarshalFrom(er.r, &pbEvent); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read event: %w", err)
+	}
+	return convertProtobufToEvent(&pbEvent)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestEvent(t testing.TB, index int) dtos.Event {
+	event := dtos.NewEvent("test-profile", fmt.Sprintf("test-device-%d", index), "test-source")
+	event.Origin = int64(1700000000000000000 + index)
+	reading, err := dtos.NewSimpleReading("test-profile", event.DeviceName, "temperature", common.ValueTypeInt32, int32(index))
+	require.NoError(t, err)
+	reading.Origin = event.Origin
+	event.Readings = []dtos.BaseReading{reading}
+	return event
+}
+
+func TestEventBatch(t *testing.T) {
+	events := []dtos.Event{newTestEvent(t, 1), newTestEvent(t, 2), newTestEvent(t, 3)}
+	batch, err := ConvertEventsToProtobufBatch(events)
+	require.NoError(t, err)
+	data, err := proto.Marshal(batch)
+	require.NoError(t, err)
+
+	result, err := DecodeProtobufBatchToEvents(data)
+	require.NoError(t, err)
+	require.Len(t, result, len(events))
+	for i, event := range result {
+		assert.Equal(t, events[i].Id, event.Id)
+		assert.Equal(t, events[i].DeviceName, event.DeviceName)
+		assert.Equal(t, events[i].Origin, event.Origin)
+		require.Len(t, event.Readings, 1)
+		assert.Equal(t, events[i].Readings[0].Value, event.Readings[0].Value)
+	}
+
+	_, err = DecodeProtobufBatchToEvents([]byte{0xff})
+	require.Error(t, err)
+}
+
+func TestEventStream(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewEventWriter(&buf)
+	const count = 100
+	for i := 0; i < count; i++ {
+		require.NoError(t, writer.Write(newTestEvent(t, i)))
+	}
+
+	reader := NewEventReader(&buf)
+	for i := 0; i < count; i++ {
+		event, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("test-device-%d", i), event.DeviceName)
+		require.Len(t, event.Readings, 1)
+		assert.Equal(t, fmt.Sprint(i), event.Readings[0].Value)
+	}
+	_, err := reader.Read()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestEventStream_Truncated(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewEventWriter(&buf).Write(newTestEvent(t, 0)))
+	data := buf.Bytes()
+
+	_, err := NewEventReader(bytes.NewReader(data[:len(data)-1])).Read()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = NewEventReaderWithMaxSize(bytes.NewReader(data), 8).Read()
+	require.Error(t, err)
+}
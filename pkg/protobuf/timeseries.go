@@ -0,0 +1,262 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/spf13/cast"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ConvertTimeSeriesToProtobuf converts the time series of the resources to the columnar protobuf TimeSeriesResponse
+func ConvertTimeSeriesToProtobuf(tsResources dtos.TimeSeriesResourceMap) (*TimeSeriesResponse, error) {
+	pbResponse := &TimeSeriesResponse{
+		ApiVersion: proto.String(common.ApiVersion),
+		Resources:  make(map[string]*TimeSeriesResource, len(tsResources)),
+	}
+	for resourceName, tsResource := range tsResources {
+		if tsResource == nil {
+			continue
+		}
+		pbResource, err := convertTimeSeriesResourceToProtobuf(tsResource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the time series of resource '%s': %w", resourceName, err)
+		}
+		pbResponse.Resources[resourceName] = pbResource
+	}
+	return pbResponse, nil
+}
+
+func convertTimeSeriesResourceToProtobuf(tsResource *dtos.TimeSeriesResource) (*TimeSeriesResource, error) {
+	pbResource := &TimeSeriesResource{
+		ValueType: proto.String(tsResource.ValueType),
+		Units:     proto.String(tsResource.Units),
+		Origins:   make([]int64, len(tsResource.TimeSeries)),
+	}
+	if tsResource.MediaType != "" {
+		pbResource.MediaType = proto.String(tsResource.MediaType)
+	}
+
+	values := make([]any, len(tsResource.TimeSeries))
+	var previous int64
+	for i, pair := range tsResource.TimeSeries {
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("time series element %d is not an [origin, value] pair", i)
+		}
+		origin, err := cast.ToInt64E(pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid origin of time series element %d: %w", i, err)
+		}
+		pbResource.Origins[i] = origin - previous
+		previous = origin
+		if pair[1] == nil {
+			pbResource.NullIndexes = append(pbResource.NullIndexes, uint32(i)) // #nosec G115
+		}
+		values[i] = pair[1]
+	}
+
+	if err := setTimeSeriesValues(pbResource, values); err != nil {
+		return nil, err
+	}
+	return pbResource, nil
+}
+
+// setTimeSeriesValues sets the value column of the protobuf TimeSeriesResource
+// The typed columns are used when every non-null value has the Go type of the column, otherwise the list column is used
+func setTimeSeriesValues(pbResource *TimeSeriesResource, values []any) error {
+	if len(pbResource.NullIndexes) == len(values) {
+		return nil // only null values, no column is needed
+	}
+	if column, ok := columnOf(values, toString); ok {
+		pbResource.Values = &TimeSeriesResource_StringValues{StringValues: &StringArray{Values: column}}
+	} else if column, ok := columnOf(values, toBytes); ok {
+		pbResource.Values = &TimeSeriesResource_BytesValues{BytesValues: &BytesArray{Values: column}}
+	} else if column, ok := columnOf(values, toInt64); ok {
+		pbResource.Values = &TimeSeriesResource_IntValues{IntValues: &IntArray{Values: column}}
+	} else if column, ok := columnOf(values, toUint64); ok {
+		pbResource.Values = &TimeSeriesResource_UintValues{UintValues: &UintArray{Values: column}}
+	} else if column, ok := columnOf(values, toFloat32); ok {
+		pbResource.Values = &TimeSeriesResource_FloatValues{FloatValues: &FloatArray{Values: column}}
+	} else if column, ok := columnOf(values, toFloat64); ok {
+		pbResource.Values = &TimeSeriesResource_DoubleValues{DoubleValues: &DoubleArray{Values: column}}
+	} else if column, ok := columnOf(values, toBool); ok {
+		pbResource.Values = &TimeSeriesResource_BoolValues{BoolValues: &BoolArray{Values: column}}
+	} else {
+		column := make([]*structpb.Value, len(values))
+		for i, value := range values {
+			pbValue, err := newStructValue(value)
+			if err != nil {
+				return fmt.Errorf("unsupported value of time series element %d: %w", i, err)
+			}
+			column[i] = pbValue
+		}
+		pbResource.Values = &TimeSeriesResource_ListValues{ListValues: &structpb.ListValue{Values: column}}
+	}
+	return nil
+}
+
+// columnOf converts the values to a typed column, leaving the zero value at the null values
+// It returns false if any non-null value doesn't have the Go type of the column
+func columnOf[T any](values []any, convert func(any) (T, bool)) ([]T, bool) {
+	column := make([]T, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		v, ok := convert(value)
+		if !ok {
+			return nil, false
+		}
+		column[i] = v
+	}
+	return column, true
+}
+
+func toString(value any) (string, bool) {
+	v, ok := value.(string)
+	return v, ok
+}
+
+func toBytes(value any) ([]byte, bool) {
+	v, ok := value.([]byte)
+	return v, ok
+}
+
+func toFloat32(value any) (float32, bool) {
+	v, ok := value.(float32)
+	return v, ok
+}
+
+func toFloat64(value any) (float64, bool) {
+	v, ok := value.(float64)
+	return v, ok
+}
+
+func toBool(value any) (bool, bool) {
+	v, ok := value.(bool)
+	return v, ok
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+func toUint64(value any) (uint64, bool) {
+	switch v := value.(type) {
+	case uint:
+		return uint64(v), true
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	}
+	return 0, false
+}
+
+// DecodeProtobufToTimeSeries decodes the protobuf TimeSeriesResponse bytes to the time series of the resources
+func DecodeProtobufToTimeSeries(data []byte) (dtos.TimeSeriesResourceMap, error) {
+	var pbResponse TimeSeriesResponse
+	if err := proto.Unmarshal(data, &pbResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf time series: %w", err)
+	}
+	return ConvertProtobufToTimeSeries(&pbResponse)
+}
+
+// ConvertProtobufToTimeSeries converts the protobuf TimeSeriesResponse to the time series of the resources
+func ConvertProtobufToTimeSeries(pbResponse *TimeSeriesResponse) (dtos.TimeSeriesResourceMap, error) {
+	tsResources := make(dtos.TimeSeriesResourceMap, len(pbResponse.GetResources()))
+	for resourceName, pbResource := range pbResponse.GetResources() {
+		tsResource, err := convertProtobufToTimeSeriesResource(pbResource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the time series of resource '%s': %w", resourceName, err)
+		}
+		tsResources[resourceName] = tsResource
+	}
+	return tsResources, nil
+}
+
+func convertProtobufToTimeSeriesResource(pbResource *TimeSeriesResource) (*dtos.TimeSeriesResource, error) {
+	origins := pbResource.GetOrigins()
+	values, err := timeSeriesValues(pbResource, len(origins))
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range pbResource.GetNullIndexes() {
+		if int(i) >= len(values) {
+			return nil, fmt.Errorf("null index %d out of range", i)
+		}
+		values[i] = nil
+	}
+
+	tsResource := &dtos.TimeSeriesResource{
+		ValueType:  pbResource.GetValueType(),
+		Units:      pbResource.GetUnits(),
+		MediaType:  pbResource.GetMediaType(),
+		TimeSeries: make([][]any, len(origins)),
+	}
+	var origin int64
+	for i, delta := range origins {
+		origin += delta
+		tsResource.TimeSeries[i] = []any{origin, values[i]}
+	}
+	return tsResource, nil
+}
+
+// timeSeriesValues returns the value column of the protobuf TimeSeriesResource, restoring the Go types of the value type
+func timeSeriesValues(pbResource *TimeSeriesResource, length int) ([]any, error) {
+	valueType := pbResource.GetValueType()
+	switch v := pbResource.GetValues().(type) {
+	case nil:
+		return make([]any, length), nil
+	case *TimeSeriesResource_IntValues:
+		return toValues(v.IntValues.GetValues(), length, func(value int64) any { return intValue(valueType, value) })
+	case *TimeSeriesResource_UintValues:
+		return toValues(v.UintValues.GetValues(), length, func(value uint64) any { return uintValue(valueType, value) })
+	case *TimeSeriesResource_FloatValues:
+		return toValues(v.FloatValues.GetValues(), length, func(value float32) any { return value })
+	case *TimeSeriesResource_DoubleValues:
+		return toValues(v.DoubleValues.GetValues(), length, func(value float64) any { return value })
+	case *TimeSeriesResource_BoolValues:
+		return toValues(v.BoolValues.GetValues(), length, func(value bool) any { return value })
+	case *TimeSeriesResource_StringValues:
+		return toValues(v.StringValues.GetValues(), length, func(value string) any { return value })
+	case *TimeSeriesResource_BytesValues:
+		return toValues(v.BytesValues.GetValues(), length, func(value []byte) any { return value })
+	case *TimeSeriesResource_ListValues:
+		return toValues(v.ListValues.GetValues(), length, (*structpb.Value).AsInterface)
+	}
+	return nil, fmt.Errorf("unsupported time series value column %T", pbResource.GetValues())
+}
+
+func toValues[T any](column []T, length int, convert func(T) any) ([]any, error) {
+	if len(column) != length {
+		return nil, fmt.Errorf("the number of values %d doesn't match the number of origins %d", len(column), length)
+	}
+	values := make([]any, length)
+	for i, v := range column {
+		values[i] = convert(v)
+	}
+	return values, nil
+}
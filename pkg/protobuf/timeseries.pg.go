@@ -0,0 +1,525 @@
+// Copyright (C) 2026 IOTech Ltd
+//
+// Protobuf schema for time series response encoding
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: pkg/protobuf/timeseries.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimeSeriesResponse represents the time series of one or more device resources
+type TimeSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API version
+	ApiVersion *string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3,oneof" json:"api_version,omitempty"`
+	// Time series keyed by the resource name
+	Resources     map[string]*TimeSeriesResource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSeriesResponse) Reset() {
+	*x = TimeSeriesResponse{}
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesResponse) ProtoMessage() {}
+
+func (x *TimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*TimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_timeseries_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeSeriesResponse) GetApiVersion() string {
+	if x != nil && x.ApiVersion != nil {
+		return *x.ApiVersion
+	}
+	return ""
+}
+
+func (x *TimeSeriesResponse) GetResources() map[string]*TimeSeriesResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// TimeSeriesResource holds the time series of a single device resource in columnar form,
+// where the n-th origin and the n-th value form the n-th [origin, value] pair of the JSON time series
+type TimeSeriesResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value type of the resource
+	ValueType *string `protobuf:"bytes,1,opt,name=value_type,json=valueType,proto3,oneof" json:"value_type,omitempty"`
+	// Units of the resource
+	Units *string `protobuf:"bytes,2,opt,name=units,proto3,oneof" json:"units,omitempty"`
+	// Media type of the Binary resource
+	MediaType *string `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3,oneof" json:"media_type,omitempty"`
+	// Unix timestamps (nanoseconds) of the values, delta encoded:
+	// the first element is the first origin and every further element is the difference to the previous origin
+	Origins []int64 `protobuf:"zigzag64,4,rep,packed,name=origins,proto3" json:"origins,omitempty"`
+	// Indexes of the null values, which hold the zero value of the column as a placeholder
+	NullIndexes []uint32 `protobuf:"varint,5,rep,packed,name=null_indexes,json=nullIndexes,proto3" json:"null_indexes,omitempty"`
+	// Values of the time series, one column type per resource
+	// The column is chosen from the Go types of the values, and list_values is used for objects, arrays and mixed types
+	//
+	// Types that are valid to be assigned to Values:
+	//
+	//	*TimeSeriesResource_IntValues
+	//	*TimeSeriesResource_UintValues
+	//	*TimeSeriesResource_FloatValues
+	//	*TimeSeriesResource_DoubleValues
+	//	*TimeSeriesResource_BoolValues
+	//	*TimeSeriesResource_StringValues
+	//	*TimeSeriesResource_BytesValues
+	//	*TimeSeriesResource_ListValues
+	Values        isTimeSeriesResource_Values `protobuf_oneof:"values"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSeriesResource) Reset() {
+	*x = TimeSeriesResource{}
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSeriesResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesResource) ProtoMessage() {}
+
+func (x *TimeSeriesResource) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesResource.ProtoReflect.Descriptor instead.
+func (*TimeSeriesResource) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_timeseries_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeSeriesResource) GetValueType() string {
+	if x != nil && x.ValueType != nil {
+		return *x.ValueType
+	}
+	return ""
+}
+
+func (x *TimeSeriesResource) GetUnits() string {
+	if x != nil && x.Units != nil {
+		return *x.Units
+	}
+	return ""
+}
+
+func (x *TimeSeriesResource) GetMediaType() string {
+	if x != nil && x.MediaType != nil {
+		return *x.MediaType
+	}
+	return ""
+}
+
+func (x *TimeSeriesResource) GetOrigins() []int64 {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetNullIndexes() []uint32 {
+	if x != nil {
+		return x.NullIndexes
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetValues() isTimeSeriesResource_Values {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetIntValues() *IntArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_IntValues); ok {
+			return x.IntValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetUintValues() *UintArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_UintValues); ok {
+			return x.UintValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetFloatValues() *FloatArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_FloatValues); ok {
+			return x.FloatValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetDoubleValues() *DoubleArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_DoubleValues); ok {
+			return x.DoubleValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetBoolValues() *BoolArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_BoolValues); ok {
+			return x.BoolValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetStringValues() *StringArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_StringValues); ok {
+			return x.StringValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetBytesValues() *BytesArray {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_BytesValues); ok {
+			return x.BytesValues
+		}
+	}
+	return nil
+}
+
+func (x *TimeSeriesResource) GetListValues() *structpb.ListValue {
+	if x != nil {
+		if x, ok := x.Values.(*TimeSeriesResource_ListValues); ok {
+			return x.ListValues
+		}
+	}
+	return nil
+}
+
+type isTimeSeriesResource_Values interface {
+	isTimeSeriesResource_Values()
+}
+
+type TimeSeriesResource_IntValues struct {
+	IntValues *IntArray `protobuf:"bytes,6,opt,name=int_values,json=intValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_UintValues struct {
+	UintValues *UintArray `protobuf:"bytes,7,opt,name=uint_values,json=uintValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_FloatValues struct {
+	FloatValues *FloatArray `protobuf:"bytes,8,opt,name=float_values,json=floatValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_DoubleValues struct {
+	DoubleValues *DoubleArray `protobuf:"bytes,9,opt,name=double_values,json=doubleValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_BoolValues struct {
+	BoolValues *BoolArray `protobuf:"bytes,10,opt,name=bool_values,json=boolValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_StringValues struct {
+	StringValues *StringArray `protobuf:"bytes,11,opt,name=string_values,json=stringValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_BytesValues struct {
+	BytesValues *BytesArray `protobuf:"bytes,12,opt,name=bytes_values,json=bytesValues,proto3,oneof"`
+}
+
+type TimeSeriesResource_ListValues struct {
+	ListValues *structpb.ListValue `protobuf:"bytes,13,opt,name=list_values,json=listValues,proto3,oneof"`
+}
+
+func (*TimeSeriesResource_IntValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_UintValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_FloatValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_DoubleValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_BoolValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_StringValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_BytesValues) isTimeSeriesResource_Values() {}
+
+func (*TimeSeriesResource_ListValues) isTimeSeriesResource_Values() {}
+
+// StringArray holds the elements of a string column
+type StringArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringArray) Reset() {
+	*x = StringArray{}
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringArray) ProtoMessage() {}
+
+func (x *StringArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringArray.ProtoReflect.Descriptor instead.
+func (*StringArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_timeseries_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StringArray) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// BytesArray holds the elements of a binary column
+type BytesArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        [][]byte               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BytesArray) Reset() {
+	*x = BytesArray{}
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BytesArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesArray) ProtoMessage() {}
+
+func (x *BytesArray) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protobuf_timeseries_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesArray.ProtoReflect.Descriptor instead.
+func (*BytesArray) Descriptor() ([]byte, []int) {
+	return file_pkg_protobuf_timeseries_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BytesArray) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_pkg_protobuf_timeseries_proto protoreflect.FileDescriptor
+
+const file_pkg_protobuf_timeseries_proto_rawDesc = "" +
+	"\n" +
+	"\x1dpkg/protobuf/timeseries.proto\x12\bprotobuf\x1a\x1cgoogle/protobuf/struct.proto\x1a\x18pkg/protobuf/event.proto\"\xf1\x01\n" +
+	"\x12TimeSeriesResponse\x12$\n" +
+	"\vapi_version\x18\x01 \x01(\tH\x00R\n" +
+	"apiVersion\x88\x01\x01\x12I\n" +
+	"\tresources\x18\x02 \x03(\v2+.protobuf.TimeSeriesResponse.ResourcesEntryR\tresources\x1aZ\n" +
+	"\x0eResourcesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.protobuf.TimeSeriesResourceR\x05value:\x028\x01B\x0e\n" +
+	"\f_api_version\"\xbc\x05\n" +
+	"\x12TimeSeriesResource\x12\"\n" +
+	"\n" +
+	"value_type\x18\x01 \x01(\tH\x01R\tvalueType\x88\x01\x01\x12\x19\n" +
+	"\x05units\x18\x02 \x01(\tH\x02R\x05units\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tH\x03R\tmediaType\x88\x01\x01\x12\x18\n" +
+	"\aorigins\x18\x04 \x03(\x12R\aorigins\x12!\n" +
+	"\fnull_indexes\x18\x05 \x03(\rR\vnullIndexes\x123\n" +
+	"\n" +
+	"int_values\x18\x06 \x01(\v2\x12.protobuf.IntArrayH\x00R\tintValues\x126\n" +
+	"\vuint_values\x18\a \x01(\v2\x13.protobuf.UintArrayH\x00R\n" +
+	"uintValues\x129\n" +
+	"\ffloat_values\x18\b \x01(\v2\x14.protobuf.FloatArrayH\x00R\vfloatValues\x12<\n" +
+	"\rdouble_values\x18\t \x01(\v2\x15.protobuf.DoubleArrayH\x00R\fdoubleValues\x126\n" +
+	"\vbool_values\x18\n" +
+	" \x01(\v2\x13.protobuf.BoolArrayH\x00R\n" +
+	"boolValues\x12<\n" +
+	"\rstring_values\x18\v \x01(\v2\x15.protobuf.StringArrayH\x00R\fstringValues\x129\n" +
+	"\fbytes_values\x18\f \x01(\v2\x14.protobuf.BytesArrayH\x00R\vbytesValues\x12=\n" +
+	"\vlist_values\x18\r \x01(\v2\x1a.google.protobuf.ListValueH\x00R\n" +
+	"listValuesB\b\n" +
+	"\x06valuesB\r\n" +
+	"\v_value_typeB\b\n" +
+	"\x06_unitsB\r\n" +
+	"\v_media_type\"%\n" +
+	"\vStringArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"$\n" +
+	"\n" +
+	"BytesArray\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\fR\x06valuesB=Z;github.com/IOTechSystems/go-mod-central-ext/v4/pkg/protobufb\x06proto3"
+
+var (
+	file_pkg_protobuf_timeseries_proto_rawDescOnce sync.Once
+	file_pkg_protobuf_timeseries_proto_rawDescData []byte
+)
+
+func file_pkg_protobuf_timeseries_proto_rawDescGZIP() []byte {
+	file_pkg_protobuf_timeseries_proto_rawDescOnce.Do(func() {
+		file_pkg_protobuf_timeseries_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protobuf_timeseries_proto_rawDesc), len(file_pkg_protobuf_timeseries_proto_rawDesc)))
+	})
+	return file_pkg_protobuf_timeseries_proto_rawDescData
+}
+
+var file_pkg_protobuf_timeseries_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_protobuf_timeseries_proto_goTypes = []any{
+	(*TimeSeriesResponse)(nil), // 0: protobuf.TimeSeriesResponse
+	(*TimeSeriesResource)(nil), // 1: protobuf.TimeSeriesResource
+	(*StringArray)(nil),        // 2: protobuf.StringArray
+	(*BytesArray)(nil),         // 3: protobuf.BytesArray
+	nil,                        // 4: protobuf.TimeSeriesResponse.ResourcesEntry
+	(*IntArray)(nil),           // 5: protobuf.IntArray
+	(*UintArray)(nil),          // 6: protobuf.UintArray
+	(*FloatArray)(nil),         // 7: protobuf.FloatArray
+	(*DoubleArray)(nil),        // 8: protobuf.DoubleArray
+	(*BoolArray)(nil),          // 9: protobuf.BoolArray
+	(*structpb.ListValue)(nil), // 10: google.protobuf.ListValue
+}
+var file_pkg_protobuf_timeseries_proto_depIdxs = []int32{
+	4,  // 0: protobuf.TimeSeriesResponse.resources:type_name -> protobuf.TimeSeriesResponse.ResourcesEntry
+	5,  // 1: protobuf.TimeSeriesResource.int_values:type_name -> protobuf.IntArray
+	6,  // 2: protobuf.TimeSeriesResource.uint_values:type_name -> protobuf.UintArray
+	7,  // 3: protobuf.TimeSeriesResource.float_values:type_name -> protobuf.FloatArray
+	8,  // 4: protobuf.TimeSeriesResource.double_values:type_name -> protobuf.DoubleArray
+	9,  // 5: protobuf.TimeSeriesResource.bool_values:type_name -> protobuf.BoolArray
+	2,  // 6: protobuf.TimeSeriesResource.string_values:type_name -> protobuf.StringArray
+	3,  // 7: protobuf.TimeSeriesResource.bytes_values:type_name -> protobuf.BytesArray
+	10, // 8: protobuf.TimeSeriesResource.list_values:type_name -> google.protobuf.ListValue
+	1,  // 9: protobuf.TimeSeriesResponse.ResourcesEntry.value:type_name -> protobuf.TimeSeriesResource
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protobuf_timeseries_proto_init() }
+func file_pkg_protobuf_timeseries_proto_init() {
+	if File_pkg_protobuf_timeseries_proto != nil {
+		return
+	}
+	file_pkg_protobuf_event_proto_init()
+	file_pkg_protobuf_timeseries_proto_msgTypes[0].OneofWrappers = []any{}
+	file_pkg_protobuf_timeseries_proto_msgTypes[1].OneofWrappers = []any{
+		(*TimeSeriesResource_IntValues)(nil),
+		(*TimeSeriesResource_UintValues)(nil),
+		(*TimeSeriesResource_FloatValues)(nil),
+		(*TimeSeriesResource_DoubleValues)(nil),
+		(*TimeSeriesResource_BoolValues)(nil),
+		(*TimeSeriesResource_StringValues)(nil),
+		(*TimeSeriesResource_BytesValues)(nil),
+		(*TimeSeriesResource_ListValues)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protobuf_timeseries_proto_rawDesc), len(file_pkg_protobuf_timeseries_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_protobuf_timeseries_proto_goTypes,
+		DependencyIndexes: file_pkg_protobuf_timeseries_proto_depIdxs,
+		MessageInfos:      file_pkg_protobuf_timeseries_proto_msgTypes,
+	}.Build()
+	File_pkg_protobuf_timeseries_proto = out.File
+	file_pkg_protobuf_timeseries_proto_goTypes = nil
+	file_pkg_protobuf_timeseries_proto_depIdxs = nil
+}
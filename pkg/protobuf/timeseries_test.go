@@ -0,0 +1,88 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/dtos"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTimeSeries(t *testing.T) {
+	tsResources := dtos.TimeSeriesResourceMap{
+		"int8":    {ValueType: common.ValueTypeInt8, Units: "C", TimeSeries: [][]any{{int64(300), int8(-3)}, {int64(200), nil}, {int64(100), int8(1)}}},
+		"uint32":  {ValueType: common.ValueTypeUint32, TimeSeries: [][]any{{int64(1), uint32(7)}}},
+		"float32": {ValueType: common.ValueTypeFloat32, TimeSeries: [][]any{{int64(1), float32(1.5)}, {int64(2), float32(-2.5)}}},
+		"float64": {ValueType: common.ValueTypeFloat64, TimeSeries: [][]any{{int64(1), 1.25}}},
+		"bool":    {ValueType: common.ValueTypeBool, TimeSeries: [][]any{{int64(1), true}, {int64(2), false}}},
+		"string":  {ValueType: common.ValueTypeInt16, TimeSeries: [][]any{{int64(1), "16"}}},
+		"binary":  {ValueType: common.ValueTypeBinary, MediaType: "image/png", TimeSeries: [][]any{{int64(1), []byte{1, 2}}, {int64(2), nil}}},
+		"object":  {ValueType: common.ValueTypeObject, TimeSeries: [][]any{{int64(1), map[string]any{"on": true}}, {int64(2), nil}}},
+		"null":    {ValueType: common.ValueTypeInt32, TimeSeries: [][]any{{int64(1), nil}}},
+		"empty":   {ValueType: common.ValueTypeInt32, TimeSeries: [][]any{}},
+	}
+	pbResponse, err := ConvertTimeSeriesToProtobuf(tsResources)
+	require.NoError(t, err)
+	assert.Equal(t, common.ApiVersion, pbResponse.GetApiVersion())
+
+	int8Resource := pbResponse.GetResources()["int8"]
+	assert.Equal(t, []int64{300, -100, -100}, int8Resource.GetOrigins())
+	assert.Equal(t, []uint32{1}, int8Resource.GetNullIndexes())
+	assert.Equal(t, []int64{-3, 0, 1}, int8Resource.GetIntValues().GetValues())
+	assert.NotNil(t, pbResponse.GetResources()["uint32"].GetUintValues())
+	assert.NotNil(t, pbResponse.GetResources()["float32"].GetFloatValues())
+	assert.NotNil(t, pbResponse.GetResources()["float64"].GetDoubleValues())
+	assert.NotNil(t, pbResponse.GetResources()["bool"].GetBoolValues())
+	assert.NotNil(t, pbResponse.GetResources()["string"].GetStringValues())
+	assert.NotNil(t, pbResponse.GetResources()["binary"].GetBytesValues())
+	assert.NotNil(t, pbResponse.GetResources()["object"].GetListValues())
+	assert.Nil(t, pbResponse.GetResources()["null"].GetValues())
+
+	data, err := proto.Marshal(pbResponse)
+	require.NoError(t, err)
+	result, err := DecodeProtobufToTimeSeries(data)
+	require.NoError(t, err)
+	assert.Equal(t, tsResources, result)
+}
+
+func TestTimeSeries_MixedValues(t *testing.T) {
+	tsResources := dtos.TimeSeriesResourceMap{
+		"mixed": {ValueType: common.ValueTypeInt32, TimeSeries: [][]any{{int64(1), "5"}, {int64(2), int32(6)}}},
+	}
+	pbResponse, err := ConvertTimeSeriesToProtobuf(tsResources)
+	require.NoError(t, err)
+	assert.NotNil(t, pbResponse.GetResources()["mixed"].GetListValues())
+
+	result, err := ConvertProtobufToTimeSeries(pbResponse)
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{int64(1), "5"}, {int64(2), float64(6)}}, result["mixed"].TimeSeries)
+}
+
+func TestTimeSeries_Invalid(t *testing.T) {
+	_, err := ConvertTimeSeriesToProtobuf(dtos.TimeSeriesResourceMap{
+		"resource": {ValueType: common.ValueTypeInt32, TimeSeries: [][]any{{int64(1)}}},
+	})
+	require.Error(t, err)
+	_, err = ConvertTimeSeriesToProtobuf(dtos.TimeSeriesResourceMap{
+		"resource": {ValueType: common.ValueTypeInt32, TimeSeries: [][]any{{"origin", int32(1)}}},
+	})
+	require.Error(t, err)
+
+	_, err = ConvertProtobufToTimeSeries(&TimeSeriesResponse{Resources: map[string]*TimeSeriesResource{
+		"resource": {Origins: []int64{1, 1}, Values: &TimeSeriesResource_IntValues{IntValues: &IntArray{Values: []int64{1}}}},
+	}})
+	require.Error(t, err)
+	_, err = ConvertProtobufToTimeSeries(&TimeSeriesResponse{Resources: map[string]*TimeSeriesResource{
+		"resource": {Origins: []int64{1}, NullIndexes: []uint32{1}},
+	}})
+	require.Error(t, err)
+
+	_, err = DecodeProtobufToTimeSeries([]byte{0xff})
+	require.Error(t, err)
+}
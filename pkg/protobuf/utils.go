@@ -102,8 +102,8 @@ func convertReadingToProtobuf(reading dtos.BaseReading) (*Reading, error) {
 			pbReading.MediaType = &reading.MediaType
 		}
 	case common.ValueTypeObject, common.ValueTypeObjectArray:
-		if reading.ObjectValue != nil {
-			// Encode object value as MessagePack bytes to preserve type information
+		if reading.ObjectValue != nil && !setNativeObjectValue(pbReading, reading.ObjectValue) {
+			// Fall back to MessagePack bytes for object values without a protobuf Struct or ListValue representation
 			msgpackBytes, err := msgpack.Marshal(reading.ObjectValue)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal object value: %w", err)
@@ -112,14 +112,15 @@ func convertReadingToProtobuf(reading dtos.BaseReading) (*Reading, error) {
 		}
 	default:
 		// SimpleReading or NumericReading
-		if reading.NumericValue != nil {
+		if reading.NumericValue == nil {
+			pbReading.Value = &reading.Value
+		} else if !setNativeNumericValue(pbReading, reading.NumericValue) {
+			// Fall back to MessagePack bytes for numeric values without a native protobuf representation
 			msgpackBytes, err := msgpack.Marshal(reading.NumericValue)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal numeric value: %w", err)
 			}
 			pbReading.NumericValue = msgpackBytes
-		} else {
-			pbReading.Value = &reading.Value
 		}
 	}
 
@@ -143,12 +144,18 @@ func convertReadingToProtobuf(reading dtos.BaseReading) (*Reading, error) {
 }
 
 // DecodeProtobufToEvent decodes protobuf bytes back to EdgeX Event
+// See EventDecoder for decoding many Events without allocating a new Event for each
 func DecodeProtobufToEvent(data []byte) (*dtos.Event, error) {
 	var pbEvent Event
 	if err := proto.Unmarshal(data, &pbEvent); err != nil {
 		return nil, err
 	}
 
+	return convertProtobufToEvent(&pbEvent)
+}
+
+// convertProtobufToEvent converts protobuf Event to dtos.Event
+func convertProtobufToEvent(pbEvent *Event) (*dtos.Event, error) {
 	event := &dtos.Event{
 		Versionable: dtoCommon.Versionable{
 			ApiVersion: pbEvent.GetApiVersion(),
@@ -227,6 +234,14 @@ func convertProtobufToReading(pbReading *Reading) (dtos.BaseReading, error) {
 		return nullReading, nil
 	}
 
+	if err := setReadingValue(&reading, pbReading); err != nil {
+		return reading, err
+	}
+	return reading, nil
+}
+
+// setReadingValue sets the Binary, Object, Numeric or Simple reading value from the protobuf Reading
+func setReadingValue(reading *dtos.BaseReading, pbReading *Reading) error {
 	switch pbReading.GetValueType() {
 	case common.ValueTypeBinary:
 		reading.BinaryReading = dtos.BinaryReading{
@@ -234,20 +249,28 @@ func convertProtobufToReading(pbReading *Reading) (dtos.BaseReading, error) {
 			MediaType:   pbReading.GetMediaType(),
 		}
 	case common.ValueTypeObject, common.ValueTypeObjectArray:
-		if len(pbReading.GetObjectValue()) > 0 {
+		if objectValue, ok := nativeObjectValue(pbReading); ok {
+			reading.ObjectReading = dtos.ObjectReading{
+				ObjectValue: objectValue,
+			}
+		} else if len(pbReading.GetObjectValue()) > 0 { // legacy MessagePack-encoded object value
 			var objectValue any
 			if err := msgpack.Unmarshal(pbReading.GetObjectValue(), &objectValue); err != nil {
-				return reading, fmt.Errorf("failed to unmarshal object value: %w", err)
+				return fmt.Errorf("failed to unmarshal object value: %w", err)
 			}
 			reading.ObjectReading = dtos.ObjectReading{
 				ObjectValue: objectValue,
 			}
 		}
 	default:
-		if len(pbReading.GetNumericValue()) > 0 { // NumericReading
+		if numericValue, ok := nativeNumericValue(pbReading); ok { // NumericReading
+			reading.NumericReading = dtos.NumericReading{
+				NumericValue: numericValue,
+			}
+		} else if len(pbReading.GetNumericValue()) > 0 { // legacy MessagePack-encoded NumericReading
 			var numericValue any
 			if err := msgpack.Unmarshal(pbReading.GetNumericValue(), &numericValue); err != nil {
-				return reading, fmt.Errorf("failed to unmarshal numeric value: %w", err)
+				return fmt.Errorf("failed to unmarshal numeric value: %w", err)
 			}
 			// MessagePack preserves the original value type, so we can use it directly
 			reading.NumericReading = dtos.NumericReading{
@@ -259,6 +282,5 @@ func convertProtobufToReading(pbReading *Reading) (dtos.BaseReading, error) {
 			}
 		}
 	}
-
-	return reading, nil
+	return nil
 }
@@ -0,0 +1,157 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package protobuf
+
+import (
+	"math"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+func encodeDecodeReading(t *testing.T, reading dtos.BaseReading) (*Reading, dtos.BaseReading) {
+	event := dtos.NewEvent("test-profile", "test-device", "test-source")
+	event.Readings = []dtos.BaseReading{reading}
+	pbEvent, err := ConvertEventToProtobuf(event)
+	require.NoError(t, err)
+	data, err := proto.Marshal(pbEvent)
+	require.NoError(t, err)
+
+	result, err := DecodeProtobufToEvent(data)
+	require.NoError(t, err)
+	require.Len(t, result.Readings, 1)
+	return pbEvent.GetReadings()[0], result.Readings[0]
+}
+
+func TestNativeNumericValue(t *testing.T) {
+	tests := []struct {
+		valueType string
+		value     any
+	}{
+		{common.ValueTypeInt8, int8(-8)},
+		{common.ValueTypeInt16, int16(-16)},
+		{common.ValueTypeInt32, int32(-32)},
+		{common.ValueTypeInt64, int64(-64)},
+		{common.ValueTypeUint8, uint8(8)},
+		{common.ValueTypeUint16, uint16(16)},
+		{common.ValueTypeUint32, uint32(32)},
+		{common.ValueTypeUint64, uint64(1 << 63)},
+		{common.ValueTypeFloat32, float32(1.5)},
+		{common.ValueTypeFloat64, float64(-2.5)},
+		{common.ValueTypeBool, true},
+		{common.ValueTypeInt8Array, []int8{-1, 1}},
+		{common.ValueTypeInt16Array, []int16{-1, 1}},
+		{common.ValueTypeInt32Array, []int32{-1, 1}},
+		{common.ValueTypeInt64Array, []int64{-1, 1}},
+		{common.ValueTypeUint8Array, []uint8{1, 2}},
+		{common.ValueTypeUint16Array, []uint16{1, 2}},
+		{common.ValueTypeUint32Array, []uint32{1, 2}},
+		{common.ValueTypeUint64Array, []uint64{1, 2}},
+		{common.ValueTypeFloat32Array, []float32{1.5, 2.5}},
+		{common.ValueTypeFloat64Array, []float64{1.5, 2.5}},
+		{common.ValueTypeBoolArray, []bool{true, false}},
+		{common.ValueTypeFloat64Array, []float64{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.valueType, func(t *testing.T) {
+			reading, err := dtos.NewNumericReading("test-profile", "test-device", "resource", testCase.valueType, testCase.value)
+			require.NoError(t, err)
+
+			pbReading, result := encodeDecodeReading(t, reading)
+			assert.NotNil(t, pbReading.GetNativeValue())
+			assert.Empty(t, pbReading.GetNumericValue())
+			assert.Equal(t, testCase.value, result.NumericValue)
+		})
+	}
+}
+
+func TestNativeObjectValue(t *testing.T) {
+	object := map[string]any{
+		"name":   "motor",
+		"speed":  1200.5,
+		"tags":   []any{"a", "b"},
+		"nested": map[string]any{"on": true},
+	}
+	pbReading, result := encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", object))
+	assert.NotNil(t, pbReading.GetStructValue())
+	assert.Empty(t, pbReading.GetObjectValue())
+	assert.Equal(t, object, result.ObjectValue)
+
+	// Go types unknown to structpb are normalized through JSON
+	pbReading, result = encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", map[string]any{"values": []int32{1, 2}}))
+	assert.NotNil(t, pbReading.GetStructValue())
+	assert.Equal(t, map[string]any{"values": []any{float64(1), float64(2)}}, result.ObjectValue)
+
+	objects := []any{map[string]any{"id": "a"}, map[string]any{"id": "b"}}
+	pbReading, result = encodeDecodeReading(t, dtos.NewObjectReadingWithArray("test-profile", "test-device", "resource", objects))
+	assert.NotNil(t, pbReading.GetListValue())
+	assert.Equal(t, objects, result.ObjectValue)
+}
+
+func TestObjectValueIntegerPrecision(t *testing.T) {
+	// structpb stores numbers as float64, so integers within float64 precision are decoded as float64
+	pbReading, result := encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", map[string]any{"count": int64(1 << 53)}))
+	assert.NotNil(t, pbReading.GetStructValue())
+	assert.Equal(t, map[string]any{"count": float64(1 << 53)}, result.ObjectValue)
+
+	// integers beyond float64 precision are kept exactly in the legacy MessagePack object_value
+	object := map[string]any{
+		"signed":   int64(1<<60 + 1),
+		"unsigned": uint64(math.MaxUint64),
+		"nested":   []any{map[string]any{"id": int64(-(1<<53 + 1))}},
+	}
+	pbReading, result = encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", object))
+	assert.Nil(t, pbReading.GetNativeValue())
+	assert.NotEmpty(t, pbReading.GetObjectValue())
+	assert.Equal(t, object, result.ObjectValue)
+
+	// Go types unknown to structpb are checked after the JSON normalization
+	values := map[string]any{"values": []uint64{1, math.MaxUint64}}
+	pbReading, result = encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", values))
+	assert.Nil(t, pbReading.GetNativeValue())
+	assert.NotEmpty(t, pbReading.GetObjectValue())
+	assert.Equal(t, map[string]any{"values": []any{uint64(1), uint64(math.MaxUint64)}}, result.ObjectValue)
+}
+
+func TestLegacyMsgpackValues(t *testing.T) {
+	numericValue, err := msgpack.Marshal(int32(-5))
+	require.NoError(t, err)
+	objectValue, err := msgpack.Marshal(map[string]any{"level": "high"})
+	require.NoError(t, err)
+
+	pbEvent := &Event{
+		DeviceName: proto.String("test-device"),
+		Readings: []*Reading{
+			{ValueType: proto.String(common.ValueTypeInt32), NumericValue: numericValue},
+			{ValueType: proto.String(common.ValueTypeObject), ObjectValue: objectValue},
+		},
+	}
+	data, err := proto.Marshal(pbEvent)
+	require.NoError(t, err)
+
+	event, err := DecodeProtobufToEvent(data)
+	require.NoError(t, err)
+	require.Len(t, event.Readings, 2)
+	assert.EqualValues(t, -5, event.Readings[0].NumericValue)
+	assert.Equal(t, map[string]any{"level": "high"}, event.Readings[1].ObjectValue)
+}
+
+func TestLegacyMsgpackFallback(t *testing.T) {
+	reading, err := dtos.NewNumericReading("test-profile", "test-device", "resource", common.ValueTypeString, "not numeric")
+	require.NoError(t, err)
+	pbReading, result := encodeDecodeReading(t, reading)
+	assert.Nil(t, pbReading.GetNativeValue())
+	assert.NotEmpty(t, pbReading.GetNumericValue())
+	assert.Equal(t, "not numeric", result.NumericValue)
+
+	pbReading, result = encodeDecodeReading(t, dtos.NewObjectReading("test-profile", "test-device", "resource", "scalar"))
+	assert.Nil(t, pbReading.GetNativeValue())
+	assert.NotEmpty(t, pbReading.GetObjectValue())
+	assert.Equal(t, "scalar", result.ObjectValue)
+}
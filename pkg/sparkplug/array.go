@@ -0,0 +1,190 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+)
+
+// boolArrayCountSize is the length of the little-endian element count which prefixes a packed BooleanArray
+const boolArrayCountSize = 4
+
+// decodeArray unpacks the bytes_value of an array metric into the Go slice matching the DataType
+// The array encoding follows the Sparkplug B specification: fixed-size elements are little-endian,
+// BooleanArray is a 4-byte element count followed by the bits packed MSB first, and StringArray
+// is a sequence of null-terminated UTF-8 strings
+func decodeArray(dataType protobuf.DataType, data []byte) (any, error) {
+	switch dataType {
+	case protobuf.DataType_Int8Array:
+		values := make([]int8, len(data))
+		for i, b := range data {
+			values[i] = int8(b)
+		}
+		return values, nil
+	case protobuf.DataType_UInt8Array:
+		return bytes.Clone(data), nil
+	case protobuf.DataType_Int16Array:
+		return decodeFixedArray(data, 2, func(b []byte) int16 { return int16(binary.LittleEndian.Uint16(b)) })
+	case protobuf.DataType_UInt16Array:
+		return decodeFixedArray(data, 2, binary.LittleEndian.Uint16)
+	case protobuf.DataType_Int32Array:
+		return decodeFixedArray(data, 4, func(b []byte) int32 { return int32(binary.LittleEndian.Uint32(b)) })
+	case protobuf.DataType_UInt32Array:
+		return decodeFixedArray(data, 4, binary.LittleEndian.Uint32)
+	case protobuf.DataType_Int64Array:
+		return decodeFixedArray(data, 8, func(b []byte) int64 { return int64(binary.LittleEndian.Uint64(b)) })
+	case protobuf.DataType_UInt64Array, protobuf.DataType_DateTimeArray:
+		return decodeFixedArray(data, 8, binary.LittleEndian.Uint64)
+	case protobuf.DataType_FloatArray:
+		return decodeFixedArray(data, 4, func(b []byte) float32 { return math.Float32frombits(binary.LittleEndian.Uint32(b)) })
+	case protobuf.DataType_DoubleArray:
+		return decodeFixedArray(data, 8, func(b []byte) float64 { return math.Float64frombits(binary.LittleEndian.Uint64(b)) })
+	case protobuf.DataType_BooleanArray:
+		return decodeBooleanArray(data)
+	case protobuf.DataType_StringArray:
+		return decodeStringArray(data)
+	default:
+		return nil, fmt.Errorf("DataType '%s' is not an array type", dataType)
+	}
+}
+
+func decodeFixedArray[T any](data []byte, size int, decode func([]byte) T) ([]T, error) {
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("array length %d is not a multiple of the element size %d", len(data), size)
+	}
+	values := make([]T, len(data)/size)
+	for i := range values {
+		values[i] = decode(data[i*size : (i+1)*size])
+	}
+	return values, nil
+}
+
+func decodeBooleanArray(data []byte) ([]bool, error) {
+	if len(data) < boolArrayCountSize {
+		return nil, fmt.Errorf("BooleanArray requires at least %d bytes for the element count", boolArrayCountSize)
+	}
+	count := int(binary.LittleEndian.Uint32(data))
+	packed := data[boolArrayCountSize:]
+	if count > len(packed)*8 {
+		return nil, fmt.Errorf("BooleanArray declares %d elements but only carries %d bytes", count, len(packed))
+	}
+	values := make([]bool, count)
+	for i := range values {
+		values[i] = packed[i/8]&(0x80>>(i%8)) != 0
+	}
+	return values, nil
+}
+
+func decodeStringArray(data []byte) ([]string, error) {
+	values := make([]string, 0)
+	for len(data) > 0 {
+		end := bytes.IndexByte(data, 0)
+		if end == -1 {
+			return nil, fmt.Errorf("StringArray element is not null-terminated")
+		}
+		values = append(values, string(data[:end]))
+		data = data[end+1:]
+	}
+	return values, nil
+}
+
+// encodeArray packs the Go slice into the bytes_value of an array metric of the given DataType
+func encodeArray(dataType protobuf.DataType, value any) ([]byte, error) {
+	var ok bool
+	var data []byte
+	switch dataType {
+	case protobuf.DataType_Int8Array:
+		var values []int8
+		if values, ok = value.([]int8); ok {
+			data = make([]byte, len(values))
+			for i, v := range values {
+				data[i] = byte(v)
+			}
+		}
+	case protobuf.DataType_UInt8Array:
+		var values []uint8
+		if values, ok = value.([]uint8); ok {
+			data = bytes.Clone(values)
+		}
+	case protobuf.DataType_Int16Array:
+		var values []int16
+		if values, ok = value.([]int16); ok {
+			data = encodeFixedArray(values, 2, func(b []byte, v int16) { binary.LittleEndian.PutUint16(b, uint16(v)) })
+		}
+	case protobuf.DataType_UInt16Array:
+		var values []uint16
+		if values, ok = value.([]uint16); ok {
+			data = encodeFixedArray(values, 2, binary.LittleEndian.PutUint16)
+		}
+	case protobuf.DataType_Int32Array:
+		var values []int32
+		if values, ok = value.([]int32); ok {
+			data = encodeFixedArray(values, 4, func(b []byte, v int32) { binary.LittleEndian.PutUint32(b, uint32(v)) })
+		}
+	case protobuf.DataType_UInt32Array:
+		var values []uint32
+		if values, ok = value.([]uint32); ok {
+			data = encodeFixedArray(values, 4, binary.LittleEndian.PutUint32)
+		}
+	case protobuf.DataType_Int64Array:
+		var values []int64
+		if values, ok = value.([]int64); ok {
+			data = encodeFixedArray(values, 8, func(b []byte, v int64) { binary.LittleEndian.PutUint64(b, uint64(v)) })
+		}
+	case protobuf.DataType_UInt64Array, protobuf.DataType_DateTimeArray:
+		var values []uint64
+		if values, ok = value.([]uint64); ok {
+			data = encodeFixedArray(values, 8, binary.LittleEndian.PutUint64)
+		}
+	case protobuf.DataType_FloatArray:
+		var values []float32
+		if values, ok = value.([]float32); ok {
+			data = encodeFixedArray(values, 4, func(b []byte, v float32) { binary.LittleEndian.PutUint32(b, math.Float32bits(v)) })
+		}
+	case protobuf.DataType_DoubleArray:
+		var values []float64
+		if values, ok = value.([]float64); ok {
+			data = encodeFixedArray(values, 8, func(b []byte, v float64) { binary.LittleEndian.PutUint64(b, math.Float64bits(v)) })
+		}
+	case protobuf.DataType_BooleanArray:
+		var values []bool
+		if values, ok = value.([]bool); ok {
+			data = make([]byte, boolArrayCountSize+(len(values)+7)/8)
+			binary.LittleEndian.PutUint32(data, uint32(len(values))) // #nosec G115
+			for i, v := range values {
+				if v {
+					data[boolArrayCountSize+i/8] |= 0x80 >> (i % 8)
+				}
+			}
+		}
+	case protobuf.DataType_StringArray:
+		var values []string
+		if values, ok = value.([]string); ok {
+			var buf bytes.Buffer
+			for _, v := range values {
+				buf.WriteString(v)
+				buf.WriteByte(0)
+			}
+			data = buf.Bytes()
+		}
+	default:
+		return nil, fmt.Errorf("DataType '%s' is not an array type", dataType)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid value type %T for DataType '%s'", value, dataType)
+	}
+	return data, nil
+}
+
+func encodeFixedArray[T any](values []T, size int, encode func([]byte, T)) []byte {
+	data := make([]byte, len(values)*size)
+	for i, v := range values {
+		encode(data[i*size:(i+1)*size], v)
+	}
+	return data
+}
@@ -0,0 +1,526 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/spf13/cast"
+)
+
+// defaultMediaType is the media type of the Bytes and File metrics without the content_type metadata
+const defaultMediaType = "application/octet-stream"
+
+// MetricDefinition describes a metric declared in an NBIRTH or DBIRTH payload
+type MetricDefinition struct {
+	Name     string
+	DataType protobuf.DataType
+}
+
+// AliasTable stores the metric definitions declared in a BIRTH payload, looked up by alias and by name
+// DATA payloads may omit the metric name and datatype once an alias has been declared at birth,
+// so the table is required to decode them
+type AliasTable struct {
+	aliases map[uint64]MetricDefinition
+	names   map[string]MetricDefinition
+}
+
+// NewAliasTable collects the metric definitions declared in the given NBIRTH or DBIRTH payload
+func NewAliasTable(birth *protobuf.Payload) *AliasTable {
+	table := &AliasTable{
+		aliases: make(map[uint64]MetricDefinition),
+		names:   make(map[string]MetricDefinition),
+	}
+	for _, metric := range birth.GetMetrics() {
+		if metric.GetName() == "" {
+			continue
+		}
+		definition := MetricDefinition{Name: metric.GetName(), DataType: protobuf.DataType(metric.GetDatatype())}
+		table.names[definition.Name] = definition
+		if metric.Alias != nil {
+			table.aliases[metric.GetAlias()] = definition
+		}
+	}
+	return table
+}
+
+// Resolve returns the metric name and datatype, falling back to the BIRTH definitions when the metric omits them
+// A nil AliasTable only resolves metrics which carry both their name and datatype
+func (t *AliasTable) Resolve(metric *protobuf.Payload_Metric) (MetricDefinition, error) {
+	definition := MetricDefinition{Name: metric.GetName(), DataType: protobuf.DataType(metric.GetDatatype())}
+
+	var declared MetricDefinition
+	var found bool
+	if t != nil {
+		if definition.Name != "" {
+			declared, found = t.names[definition.Name]
+		} else if metric.Alias != nil {
+			declared, found = t.aliases[metric.GetAlias()]
+		}
+	}
+
+	if definition.Name == "" {
+		if metric.Alias == nil {
+			return definition, fmt.Errorf("metric defines neither name nor alias")
+		}
+		if !found {
+			return definition, fmt.Errorf("metric alias %d is not declared in the BIRTH message", metric.GetAlias())
+		}
+		definition.Name = declared.Name
+	}
+	if metric.Datatype == nil {
+		if !found {
+			return definition, fmt.Errorf("metric '%s' defines no datatype", definition.Name)
+		}
+		definition.DataType = declared.DataType
+	}
+	return definition, nil
+}
+
+// ConvertPayloadToEvent converts the Sparkplug Payload to dtos.Event with one reading per metric
+// The metric aliases and omitted datatypes are resolved through aliases, which may be nil for BIRTH payloads
+func ConvertPayloadToEvent(payload *protobuf.Payload, profileName, deviceName, sourceName string, aliases *AliasTable) (*dtos.Event, error) {
+	event := dtos.NewEvent(profileName, deviceName, sourceName)
+	if payload.GetTimestamp() != 0 {
+		event.Origin = toOrigin(payload.GetTimestamp())
+	}
+
+	event.Readings = make([]dtos.BaseReading, 0, len(payload.GetMetrics()))
+	for i, metric := range payload.GetMetrics() {
+		reading, err := ConvertMetricToReading(metric, profileName, deviceName, aliases)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert metric %d: %w", i, err)
+		}
+		// the metric without its own timestamp is read at the payload timestamp
+		if metric.Timestamp == nil {
+			reading.Origin = event.Origin
+		}
+		event.Readings = append(event.Readings, reading)
+	}
+
+	return &event, nil
+}
+
+// ConvertMetricToReading converts the Sparkplug Metric to dtos.BaseReading
+func ConvertMetricToReading(metric *protobuf.Payload_Metric, profileName, deviceName string, aliases *AliasTable) (dtos.BaseReading, error) {
+	definition, err := aliases.Resolve(metric)
+	if err != nil {
+		return dtos.BaseReading{}, err
+	}
+	valueType, err := ToEdgeXValueType(definition.DataType)
+	if err != nil {
+		return dtos.BaseReading{}, fmt.Errorf("failed to convert metric '%s': %w", definition.Name, err)
+	}
+
+	var reading dtos.BaseReading
+	if metric.GetIsNull() {
+		reading = dtos.NewNullReading(profileName, deviceName, definition.Name, valueType)
+	} else {
+		value, err := metricValue(metric, definition.DataType)
+		if err != nil {
+			return dtos.BaseReading{}, fmt.Errorf("failed to read the value of metric '%s': %w", definition.Name, err)
+		}
+		switch valueType {
+		case common.ValueTypeBinary:
+			mediaType := metric.GetMetadata().GetContentType()
+			if mediaType == "" {
+				mediaType = defaultMediaType
+			}
+			reading = dtos.NewBinaryReading(profileName, deviceName, definition.Name, value.([]byte), mediaType)
+		case common.ValueTypeObject:
+			reading = dtos.NewObjectReading(profileName, deviceName, definition.Name, value)
+		default:
+			reading, err = dtos.NewSimpleReading(profileName, deviceName, definition.Name, valueType, value)
+			if err != nil {
+				return dtos.BaseReading{}, fmt.Errorf("failed to create reading for metric '%s': %w", definition.Name, err)
+			}
+		}
+	}
+
+	if metric.Timestamp != nil {
+		reading.Origin = toOrigin(metric.GetTimestamp())
+	}
+	return reading, nil
+}
+
+// metricValue returns the metric value as the Go type expected by the EdgeX value type of the DataType
+func metricValue(metric *protobuf.Payload_Metric, dataType protobuf.DataType) (any, error) {
+	switch dataType {
+	case protobuf.DataType_Int8, protobuf.DataType_Int16, protobuf.DataType_Int32,
+		protobuf.DataType_UInt8, protobuf.DataType_UInt16, protobuf.DataType_UInt32:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_IntValue); !ok {
+			return nil, fmt.Errorf("int_value is required for DataType '%s'", dataType)
+		}
+		return fromIntValue(dataType, metric.GetIntValue()), nil
+	case protobuf.DataType_Int64, protobuf.DataType_UInt64, protobuf.DataType_DateTime:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_LongValue); !ok {
+			return nil, fmt.Errorf("long_value is required for DataType '%s'", dataType)
+		}
+		return fromLongValue(dataType, metric.GetLongValue()), nil
+	case protobuf.DataType_Float:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_FloatValue); !ok {
+			return nil, fmt.Errorf("float_value is required for DataType '%s'", dataType)
+		}
+		return metric.GetFloatValue(), nil
+	case protobuf.DataType_Double:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_DoubleValue); !ok {
+			return nil, fmt.Errorf("double_value is required for DataType '%s'", dataType)
+		}
+		return metric.GetDoubleValue(), nil
+	case protobuf.DataType_Boolean:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_BooleanValue); !ok {
+			return nil, fmt.Errorf("boolean_value is required for DataType '%s'", dataType)
+		}
+		return metric.GetBooleanValue(), nil
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_StringValue); !ok {
+			return nil, fmt.Errorf("string_value is required for DataType '%s'", dataType)
+		}
+		return metric.GetStringValue(), nil
+	case protobuf.DataType_Bytes, protobuf.DataType_File:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_BytesValue); !ok {
+			return nil, fmt.Errorf("bytes_value is required for DataType '%s'", dataType)
+		}
+		return metric.GetBytesValue(), nil
+	case protobuf.DataType_Template:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_TemplateValue); !ok {
+			return nil, fmt.Errorf("template_value is required for DataType '%s'", dataType)
+		}
+		return ConvertTemplateToObject(metric.GetTemplateValue())
+	case protobuf.DataType_DataSet:
+		if _, ok := metric.GetValue().(*protobuf.Payload_Metric_DatasetValue); !ok {
+			return nil, fmt.Errorf("dataset_value is required for DataType '%s'", dataType)
+		}
+		return ConvertDataSetToObject(metric.GetDatasetValue())
+	default:
+		if isArrayDataType(dataType) {
+			if _, ok := metric.GetValue().(*protobuf.Payload_Metric_BytesValue); !ok {
+				return nil, fmt.Errorf("bytes_value is required for DataType '%s'", dataType)
+			}
+			return decodeArray(dataType, metric.GetBytesValue())
+		}
+		return nil, fmt.Errorf("unsupported Sparkplug DataType '%s'", dataType)
+	}
+}
+
+// fromIntValue converts the uint32 int_value to the Go type of the DataType
+// Signed values are carried as two's complement, so the conversions below only reinterpret the bits
+func fromIntValue(dataType protobuf.DataType, value uint32) any {
+	switch dataType {
+	case protobuf.DataType_Int8:
+		return int8(value) // #nosec G115
+	case protobuf.DataType_Int16:
+		return int16(value) // #nosec G115
+	case protobuf.DataType_Int32:
+		return int32(value) // #nosec G115
+	case protobuf.DataType_UInt8:
+		return uint8(value) // #nosec G115
+	case protobuf.DataType_UInt16:
+		return uint16(value) // #nosec G115
+	default:
+		return value
+	}
+}
+
+// fromLongValue converts the uint64 long_value to the Go type of the DataType
+func fromLongValue(dataType protobuf.DataType, value uint64) any {
+	if dataType == protobuf.DataType_Int64 {
+		return int64(value) // #nosec G115
+	}
+	return value
+}
+
+// ConvertEventToPayload converts dtos.Event to the Sparkplug Payload with one metric per reading
+// The seq is the Sparkplug message sequence number maintained by the publisher, in the range 0-255
+func ConvertEventToPayload(event dtos.Event, seq uint64) (*protobuf.Payload, error) {
+	timestamp := toTimestamp(event.Origin)
+	if timestamp == 0 {
+		timestamp = uint64(time.Now().UnixMilli()) // #nosec G115
+	}
+	payload := &protobuf.Payload{
+		Timestamp: &timestamp,
+		Seq:       &seq,
+		Metrics:   make([]*protobuf.Payload_Metric, len(event.Readings)),
+	}
+
+	for i, reading := range event.Readings {
+		metric, err := ConvertReadingToMetric(reading)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert reading %d: %w", i, err)
+		}
+		payload.Metrics[i] = metric
+	}
+
+	return payload, nil
+}
+
+// ConvertReadingToMetric converts dtos.BaseReading to the Sparkplug Metric named after the reading resource
+func ConvertReadingToMetric(reading dtos.BaseReading) (*protobuf.Payload_Metric, error) {
+	dataType, err := ToSparkplugDataType(reading.ValueType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert reading '%s': %w", reading.ResourceName, err)
+	}
+	datatype := uint32(dataType) // #nosec G115
+	metric := &protobuf.Payload_Metric{
+		Name:     &reading.ResourceName,
+		Datatype: &datatype,
+	}
+	if reading.Origin != 0 {
+		timestamp := toTimestamp(reading.Origin)
+		metric.Timestamp = &timestamp
+	}
+
+	if reading.IsNull() {
+		isNull := true
+		metric.IsNull = &isNull
+		return metric, nil
+	}
+
+	if dataType == protobuf.DataType_Template {
+		if isDataSetObject(reading.ObjectValue) {
+			dataType = protobuf.DataType_DataSet
+			datatype = uint32(dataType) // #nosec G115
+		}
+		if err = setLooseMetricValue(metric, dataType, reading.ObjectValue); err != nil {
+			return nil, fmt.Errorf("failed to convert the object value of reading '%s': %w", reading.ResourceName, err)
+		}
+		return metric, nil
+	}
+
+	if dataType == protobuf.DataType_Bytes {
+		metric.Value = &protobuf.Payload_Metric_BytesValue{BytesValue: reading.BinaryValue}
+		if reading.MediaType != "" {
+			metric.Metadata = &protobuf.Payload_MetaData{ContentType: &reading.MediaType}
+		}
+		return metric, nil
+	}
+
+	value, err := readingValue(reading, dataType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of reading '%s': %w", reading.ResourceName, err)
+	}
+	if err = setMetricValue(metric, dataType, value); err != nil {
+		return nil, fmt.Errorf("failed to set the value of metric '%s': %w", reading.ResourceName, err)
+	}
+	return metric, nil
+}
+
+// setMetricValue sets the metric value oneof field matching the DataType
+func setMetricValue(metric *protobuf.Payload_Metric, dataType protobuf.DataType, value any) error {
+	switch v := value.(type) {
+	case int8:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)} // #nosec G115
+	case int16:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)} // #nosec G115
+	case int32:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)} // #nosec G115
+	case uint8:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)}
+	case uint16:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)}
+	case uint32:
+		metric.Value = &protobuf.Payload_Metric_IntValue{IntValue: v}
+	case int64:
+		metric.Value = &protobuf.Payload_Metric_LongValue{LongValue: uint64(v)} // #nosec G115
+	case uint64:
+		metric.Value = &protobuf.Payload_Metric_LongValue{LongValue: v}
+	case float32:
+		metric.Value = &protobuf.Payload_Metric_FloatValue{FloatValue: v}
+	case float64:
+		metric.Value = &protobuf.Payload_Metric_DoubleValue{DoubleValue: v}
+	case bool:
+		metric.Value = &protobuf.Payload_Metric_BooleanValue{BooleanValue: v}
+	case string:
+		metric.Value = &protobuf.Payload_Metric_StringValue{StringValue: v}
+	default:
+		if !isArrayDataType(dataType) {
+			return fmt.Errorf("invalid value type %T for DataType '%s'", value, dataType)
+		}
+		data, err := encodeArray(dataType, value)
+		if err != nil {
+			return err
+		}
+		metric.Value = &protobuf.Payload_Metric_BytesValue{BytesValue: data}
+	}
+	return nil
+}
+
+// readingValue parses the value of a simple or numeric reading to the Go type of the DataType
+func readingValue(reading dtos.BaseReading, dataType protobuf.DataType) (any, error) {
+	if isArrayDataType(dataType) {
+		return parseArrayValue(dataType, reading.Value)
+	}
+	if reading.NumericValue != nil {
+		return castScalarValue(dataType, reading.NumericValue)
+	}
+	return parseScalarValue(dataType, reading.Value)
+}
+
+// parseScalarValue parses the string value of a simple reading to the Go type of the DataType
+func parseScalarValue(dataType protobuf.DataType, value string) (any, error) {
+	value = strings.TrimSpace(value)
+	switch dataType {
+	case protobuf.DataType_Int8:
+		v, err := strconv.ParseInt(value, 10, 8)
+		return int8(v), err
+	case protobuf.DataType_Int16:
+		v, err := strconv.ParseInt(value, 10, 16)
+		return int16(v), err
+	case protobuf.DataType_Int32:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return int32(v), err
+	case protobuf.DataType_Int64:
+		return strconv.ParseInt(value, 10, 64)
+	case protobuf.DataType_UInt8:
+		v, err := strconv.ParseUint(value, 10, 8)
+		return uint8(v), err
+	case protobuf.DataType_UInt16:
+		v, err := strconv.ParseUint(value, 10, 16)
+		return uint16(v), err
+	case protobuf.DataType_UInt32:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return uint32(v), err
+	case protobuf.DataType_UInt64, protobuf.DataType_DateTime:
+		return strconv.ParseUint(value, 10, 64)
+	case protobuf.DataType_Float:
+		v, err := strconv.ParseFloat(value, 32)
+		return float32(v), err
+	case protobuf.DataType_Double:
+		return strconv.ParseFloat(value, 64)
+	case protobuf.DataType_Boolean:
+		return strconv.ParseBool(value)
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported Sparkplug DataType '%s'", dataType)
+	}
+}
+
+// castScalarValue casts the value of a numeric reading to the Go type of the DataType
+func castScalarValue(dataType protobuf.DataType, value any) (any, error) {
+	switch dataType {
+	case protobuf.DataType_Int8:
+		return cast.ToInt8E(value)
+	case protobuf.DataType_Int16:
+		return cast.ToInt16E(value)
+	case protobuf.DataType_Int32:
+		return cast.ToInt32E(value)
+	case protobuf.DataType_Int64:
+		return cast.ToInt64E(value)
+	case protobuf.DataType_UInt8:
+		return cast.ToUint8E(value)
+	case protobuf.DataType_UInt16:
+		return cast.ToUint16E(value)
+	case protobuf.DataType_UInt32:
+		return cast.ToUint32E(value)
+	case protobuf.DataType_UInt64, protobuf.DataType_DateTime:
+		return cast.ToUint64E(value)
+	case protobuf.DataType_Float:
+		return cast.ToFloat32E(value)
+	case protobuf.DataType_Double:
+		return cast.ToFloat64E(value)
+	case protobuf.DataType_Boolean:
+		return cast.ToBoolE(value)
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		return cast.ToStringE(value)
+	default:
+		return nil, fmt.Errorf("unsupported Sparkplug DataType '%s'", dataType)
+	}
+}
+
+// parseArrayValue parses the string value of an array reading, e.g. "[1, 2, 3]", to the Go slice of the DataType
+func parseArrayValue(dataType protobuf.DataType, value string) (any, error) {
+	if dataType == protobuf.DataType_StringArray {
+		var values []string
+		if err := json.Unmarshal([]byte(value), &values); err == nil {
+			return values, nil
+		}
+		trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]"))
+		if trimmed == "" {
+			return []string{}, nil
+		}
+		values = strings.Split(trimmed, ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return values, nil
+	}
+
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]")
+	elements := strings.FieldsFunc(trimmed, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+	elementType := arrayElementDataType(dataType)
+
+	switch dataType {
+	case protobuf.DataType_Int8Array:
+		return parseArrayElements[int8](elements, elementType)
+	case protobuf.DataType_Int16Array:
+		return parseArrayElements[int16](elements, elementType)
+	case protobuf.DataType_Int32Array:
+		return parseArrayElements[int32](elements, elementType)
+	case protobuf.DataType_Int64Array:
+		return parseArrayElements[int64](elements, elementType)
+	case protobuf.DataType_UInt8Array:
+		return parseArrayElements[uint8](elements, elementType)
+	case protobuf.DataType_UInt16Array:
+		return parseArrayElements[uint16](elements, elementType)
+	case protobuf.DataType_UInt32Array:
+		return parseArrayElements[uint32](elements, elementType)
+	case protobuf.DataType_UInt64Array, protobuf.DataType_DateTimeArray:
+		return parseArrayElements[uint64](elements, elementType)
+	case protobuf.DataType_FloatArray:
+		return parseArrayElements[float32](elements, elementType)
+	case protobuf.DataType_DoubleArray:
+		return parseArrayElements[float64](elements, elementType)
+	case protobuf.DataType_BooleanArray:
+		return parseArrayElements[bool](elements, elementType)
+	default:
+		return nil, fmt.Errorf("DataType '%s' is not an array type", dataType)
+	}
+}
+
+func parseArrayElements[T any](elements []string, elementType protobuf.DataType) ([]T, error) {
+	values := make([]T, len(elements))
+	for i, element := range elements {
+		value, err := parseScalarValue(elementType, element)
+		if err != nil {
+			return nil, fmt.Errorf("invalid array element '%s': %w", element, err)
+		}
+		values[i] = value.(T)
+	}
+	return values, nil
+}
+
+// arrayElementDataType returns the scalar DataType of the array DataType elements
+func arrayElementDataType(dataType protobuf.DataType) protobuf.DataType {
+	switch dataType {
+	case protobuf.DataType_DateTimeArray:
+		return protobuf.DataType_UInt64
+	case protobuf.DataType_BooleanArray:
+		return protobuf.DataType_Boolean
+	case protobuf.DataType_StringArray:
+		return protobuf.DataType_String
+	default:
+		// Int8Array to DoubleArray follow the same order as Int8 to Double
+		return dataType - protobuf.DataType_Int8Array + protobuf.DataType_Int8
+	}
+}
+
+// toOrigin converts the Sparkplug timestamp in milliseconds to the EdgeX origin in nanoseconds
+func toOrigin(timestamp uint64) int64 {
+	return int64(timestamp) * int64(time.Millisecond) // #nosec G115
+}
+
+// toTimestamp converts the EdgeX origin in nanoseconds to the Sparkplug timestamp in milliseconds
+func toTimestamp(origin int64) uint64 {
+	return uint64(origin / int64(time.Millisecond)) // #nosec G115
+}
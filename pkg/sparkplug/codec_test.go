@@ -0,0 +1,231 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testProfileName = "test-profile"
+	testDeviceName  = "test-device"
+	testSourceName  = "test-source"
+)
+
+func TestDataTypeMapping(t *testing.T) {
+	unsupported := map[protobuf.DataType]bool{
+		protobuf.DataType_Unknown:         true,
+		protobuf.DataType_PropertySet:     true,
+		protobuf.DataType_PropertySetList: true,
+	}
+	lossy := map[protobuf.DataType]protobuf.DataType{
+		protobuf.DataType_DateTime:      protobuf.DataType_UInt64,
+		protobuf.DataType_Text:          protobuf.DataType_String,
+		protobuf.DataType_UUID:          protobuf.DataType_String,
+		protobuf.DataType_File:          protobuf.DataType_Bytes,
+		protobuf.DataType_DateTimeArray: protobuf.DataType_UInt64Array,
+		protobuf.DataType_DataSet:       protobuf.DataType_Template,
+	}
+
+	for number, name := range protobuf.DataType_name {
+		dataType := protobuf.DataType(number)
+		t.Run(name, func(t *testing.T) {
+			valueType, err := ToEdgeXValueType(dataType)
+			if unsupported[dataType] {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			result, err := ToSparkplugDataType(valueType)
+			require.NoError(t, err)
+			expected, ok := lossy[dataType]
+			if !ok {
+				expected = dataType
+			}
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestToSparkplugDataType_Unsupported(t *testing.T) {
+	_, err := ToSparkplugDataType(common.ValueTypeObjectArray)
+	require.Error(t, err)
+	_, err = ToSparkplugDataType("invalid")
+	require.Error(t, err)
+}
+
+func TestMetricReadingRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *protobuf.Payload_Metric
+	}{
+		{"Int8", newTestMetric(protobuf.DataType_Int8, &protobuf.Payload_Metric_IntValue{IntValue: uint32(0xFFFFFF80)})},
+		{"Int16", newTestMetric(protobuf.DataType_Int16, &protobuf.Payload_Metric_IntValue{IntValue: uint32(0xFFFF8000)})},
+		{"Int32", newTestMetric(protobuf.DataType_Int32, &protobuf.Payload_Metric_IntValue{IntValue: uint32(0xFFFFFFFF)})},
+		{"Int64", newTestMetric(protobuf.DataType_Int64, &protobuf.Payload_Metric_LongValue{LongValue: uint64(0xFFFFFFFFFFFFFFFE)})},
+		{"UInt8", newTestMetric(protobuf.DataType_UInt8, &protobuf.Payload_Metric_IntValue{IntValue: 255})},
+		{"UInt16", newTestMetric(protobuf.DataType_UInt16, &protobuf.Payload_Metric_IntValue{IntValue: 65535})},
+		{"UInt32", newTestMetric(protobuf.DataType_UInt32, &protobuf.Payload_Metric_IntValue{IntValue: 4294967295})},
+		{"UInt64", newTestMetric(protobuf.DataType_UInt64, &protobuf.Payload_Metric_LongValue{LongValue: 18446744073709551615})},
+		{"Float", newTestMetric(protobuf.DataType_Float, &protobuf.Payload_Metric_FloatValue{FloatValue: 1.25})},
+		{"Double", newTestMetric(protobuf.DataType_Double, &protobuf.Payload_Metric_DoubleValue{DoubleValue: -3.5e10})},
+		{"Boolean", newTestMetric(protobuf.DataType_Boolean, &protobuf.Payload_Metric_BooleanValue{BooleanValue: true})},
+		{"String", newTestMetric(protobuf.DataType_String, &protobuf.Payload_Metric_StringValue{StringValue: "hello"})},
+		{"Bytes", func() *protobuf.Payload_Metric {
+			m := newTestMetric(protobuf.DataType_Bytes, &protobuf.Payload_Metric_BytesValue{BytesValue: []byte{1, 2, 3}})
+			m.Metadata = &protobuf.Payload_MetaData{ContentType: proto.String("image/png")}
+			return m
+		}()},
+		{"Int8Array", newTestArrayMetric(t, protobuf.DataType_Int8Array, []int8{-1, 0, 1})},
+		{"Int16Array", newTestArrayMetric(t, protobuf.DataType_Int16Array, []int16{-300, 300})},
+		{"Int32Array", newTestArrayMetric(t, protobuf.DataType_Int32Array, []int32{-70000, 70000})},
+		{"Int64Array", newTestArrayMetric(t, protobuf.DataType_Int64Array, []int64{-1 << 40, 1 << 40})},
+		{"UInt8Array", newTestArrayMetric(t, protobuf.DataType_UInt8Array, []uint8{0, 255})},
+		{"UInt16Array", newTestArrayMetric(t, protobuf.DataType_UInt16Array, []uint16{0, 65535})},
+		{"UInt32Array", newTestArrayMetric(t, protobuf.DataType_UInt32Array, []uint32{0, 4294967295})},
+		{"UInt64Array", newTestArrayMetric(t, protobuf.DataType_UInt64Array, []uint64{0, 1 << 63})},
+		{"FloatArray", newTestArrayMetric(t, protobuf.DataType_FloatArray, []float32{1.5, -2.25})},
+		{"DoubleArray", newTestArrayMetric(t, protobuf.DataType_DoubleArray, []float64{1.5e-3, -2.25e8})},
+		{"BooleanArray", newTestArrayMetric(t, protobuf.DataType_BooleanArray, []bool{true, false, true, true, false, false, true, false, true})},
+		{"StringArray", newTestArrayMetric(t, protobuf.DataType_StringArray, []string{"a", "b"})},
+		{"Null", func() *protobuf.Payload_Metric {
+			m := newTestMetric(protobuf.DataType_Int32, nil)
+			m.IsNull = proto.Bool(true)
+			return m
+		}()},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reading, err := ConvertMetricToReading(testCase.metric, testProfileName, testDeviceName, nil)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.metric.GetName(), reading.ResourceName)
+			assert.Equal(t, int64(1700000000123000000), reading.Origin)
+
+			result, err := ConvertReadingToMetric(reading)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(testCase.metric, result), "expected %v, got %v", testCase.metric, result)
+		})
+	}
+}
+
+func TestConvertMetricToReading_Binary(t *testing.T) {
+	metric := newTestMetric(protobuf.DataType_File, &protobuf.Payload_Metric_BytesValue{BytesValue: []byte{1}})
+	reading, err := ConvertMetricToReading(metric, testProfileName, testDeviceName, nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultMediaType, reading.MediaType)
+
+	metric.Metadata = &protobuf.Payload_MetaData{ContentType: proto.String("image/png")}
+	reading, err = ConvertMetricToReading(metric, testProfileName, testDeviceName, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", reading.MediaType)
+	assert.Equal(t, []byte{1}, reading.BinaryValue)
+}
+
+func TestConvertMetricToReading_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *protobuf.Payload_Metric
+	}{
+		{"no name nor alias", &protobuf.Payload_Metric{Datatype: proto.Uint32(uint32(protobuf.DataType_Int32))}},
+		{"no datatype", &protobuf.Payload_Metric{Name: proto.String("m")}},
+		{"undeclared alias", &protobuf.Payload_Metric{Alias: proto.Uint64(1), Datatype: proto.Uint32(uint32(protobuf.DataType_Int32))}},
+		{"unsupported datatype", newTestMetric(protobuf.DataType_PropertySet, nil)},
+		{"mismatched value", newTestMetric(protobuf.DataType_Int32, &protobuf.Payload_Metric_StringValue{StringValue: "1"})},
+		{"invalid array", newTestMetric(protobuf.DataType_Int32Array, &protobuf.Payload_Metric_BytesValue{BytesValue: []byte{1, 2, 3}})},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ConvertMetricToReading(testCase.metric, testProfileName, testDeviceName, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConvertPayloadToEvent_Aliases(t *testing.T) {
+	birth := &protobuf.Payload{
+		Metrics: []*protobuf.Payload_Metric{
+			{Name: proto.String("temperature"), Alias: proto.Uint64(1), Datatype: proto.Uint32(uint32(protobuf.DataType_Double))},
+			{Name: proto.String("running"), Alias: proto.Uint64(2), Datatype: proto.Uint32(uint32(protobuf.DataType_Boolean))},
+		},
+	}
+	aliases := NewAliasTable(birth)
+
+	data := &protobuf.Payload{
+		Timestamp: proto.Uint64(1700000000000),
+		Metrics: []*protobuf.Payload_Metric{
+			{Alias: proto.Uint64(1), Value: &protobuf.Payload_Metric_DoubleValue{DoubleValue: 21.5}},
+			{Alias: proto.Uint64(2), Timestamp: proto.Uint64(1700000000500), Value: &protobuf.Payload_Metric_BooleanValue{BooleanValue: true}},
+			{Name: proto.String("running"), Value: &protobuf.Payload_Metric_BooleanValue{BooleanValue: false}},
+		},
+	}
+	event, err := ConvertPayloadToEvent(data, testProfileName, testDeviceName, testSourceName, aliases)
+	require.NoError(t, err)
+	assert.Equal(t, testSourceName, event.SourceName)
+	assert.Equal(t, int64(1700000000000000000), event.Origin)
+	require.Len(t, event.Readings, 3)
+
+	assert.Equal(t, "temperature", event.Readings[0].ResourceName)
+	assert.Equal(t, common.ValueTypeFloat64, event.Readings[0].ValueType)
+	assert.Equal(t, event.Origin, event.Readings[0].Origin)
+	assert.Equal(t, "running", event.Readings[1].ResourceName)
+	assert.Equal(t, common.ValueTypeBool, event.Readings[1].ValueType)
+	assert.Equal(t, int64(1700000000500000000), event.Readings[1].Origin)
+	assert.Equal(t, "running", event.Readings[2].ResourceName)
+	assert.Equal(t, common.ValueTypeBool, event.Readings[2].ValueType)
+
+	_, err = ConvertPayloadToEvent(data, testProfileName, testDeviceName, testSourceName, nil)
+	require.Error(t, err)
+}
+
+func TestConvertEventToPayload(t *testing.T) {
+	event := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	event.Origin = 1700000000000000000
+	reading, err := dtos.NewSimpleReading(testProfileName, testDeviceName, "count", common.ValueTypeUint16, uint16(7))
+	require.NoError(t, err)
+	numeric, err := dtos.NewNumericReading(testProfileName, testDeviceName, "level", common.ValueTypeFloat32, float32(0.5))
+	require.NoError(t, err)
+	event.Readings = []dtos.BaseReading{reading, numeric}
+
+	payload, err := ConvertEventToPayload(event, 255)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1700000000000), payload.GetTimestamp())
+	assert.Equal(t, uint64(255), payload.GetSeq())
+	require.Len(t, payload.GetMetrics(), 2)
+	assert.Equal(t, "count", payload.GetMetrics()[0].GetName())
+	assert.Equal(t, uint32(protobuf.DataType_UInt16), payload.GetMetrics()[0].GetDatatype())
+	assert.Equal(t, uint32(7), payload.GetMetrics()[0].GetIntValue())
+	assert.Equal(t, "level", payload.GetMetrics()[1].GetName())
+	assert.Equal(t, float32(0.5), payload.GetMetrics()[1].GetFloatValue())
+
+	event.Readings = append(event.Readings, dtos.NewObjectReadingWithArray(testProfileName, testDeviceName, "objects", []any{}))
+	_, err = ConvertEventToPayload(event, 0)
+	require.Error(t, err)
+}
+
+func newTestMetric(dataType protobuf.DataType, value protobuf.IsPayload_Metric_Value) *protobuf.Payload_Metric {
+	return &protobuf.Payload_Metric{
+		Name:      proto.String(dataType.String()),
+		Timestamp: proto.Uint64(1700000000123),
+		Datatype:  proto.Uint32(uint32(dataType)),
+		Value:     value,
+	}
+}
+
+func newTestArrayMetric(t *testing.T, dataType protobuf.DataType, values any) *protobuf.Payload_Metric {
+	data, err := encodeArray(dataType, values)
+	require.NoError(t, err)
+	decoded, err := decodeArray(dataType, data)
+	require.NoError(t, err)
+	require.Equal(t, values, decoded)
+	return newTestMetric(dataType, &protobuf.Payload_Metric_BytesValue{BytesValue: data})
+}
@@ -0,0 +1,682 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+)
+
+// The keys of the EdgeX object values converted from Sparkplug Templates and DataSets
+// A Template is converted to
+//
+//	{"templateRef": "Motor", "version": "1.0", "isDefinition": false,
+//	 "parameters": [{"name": "rated", "dataType": "Double", "value": 1.5}],
+//	 "metrics": [{"name": "speed", "dataType": "Int32", "value": 10, "timestamp": 1700000000000}]}
+//
+// where a nested Template or DataSet metric carries the converted object as its value, and a DataSet to
+//
+//	{"columns": ["id", "label"], "types": ["Int32", "String"], "rows": [[1, "a"], [2, "b"]]}
+const (
+	ObjectKeyTemplateRef  = "templateRef"
+	ObjectKeyVersion      = "version"
+	ObjectKeyIsDefinition = "isDefinition"
+	ObjectKeyParameters   = "parameters"
+	ObjectKeyMetrics      = "metrics"
+	ObjectKeyName         = "name"
+	ObjectKeyDataType     = "dataType"
+	ObjectKeyValue        = "value"
+	ObjectKeyIsNull       = "isNull"
+	ObjectKeyTimestamp    = "timestamp"
+	ObjectKeyColumns      = "columns"
+	ObjectKeyTypes        = "types"
+	ObjectKeyRows         = "rows"
+)
+
+// ConvertTemplateToObject converts the Sparkplug Template, including nested Templates, to an EdgeX object value
+func ConvertTemplateToObject(template *protobuf.Payload_Template) (map[string]any, error) {
+	object := map[string]any{
+		ObjectKeyIsDefinition: template.GetIsDefinition(),
+	}
+	if template.TemplateRef != nil {
+		object[ObjectKeyTemplateRef] = template.GetTemplateRef()
+	}
+	if template.Version != nil {
+		object[ObjectKeyVersion] = template.GetVersion()
+	}
+
+	parameters := make([]any, len(template.GetParameters()))
+	for i, parameter := range template.GetParameters() {
+		dataType := protobuf.DataType(parameter.GetType())
+		value, err := parameterValue(parameter, dataType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert template parameter '%s': %w", parameter.GetName(), err)
+		}
+		parameters[i] = map[string]any{
+			ObjectKeyName:     parameter.GetName(),
+			ObjectKeyDataType: dataType.String(),
+			ObjectKeyValue:    value,
+		}
+	}
+	object[ObjectKeyParameters] = parameters
+
+	metrics := make([]any, len(template.GetMetrics()))
+	for i, metric := range template.GetMetrics() {
+		dataType := protobuf.DataType(metric.GetDatatype())
+		metricObject := map[string]any{
+			ObjectKeyName:     metric.GetName(),
+			ObjectKeyDataType: dataType.String(),
+		}
+		if metric.Timestamp != nil {
+			metricObject[ObjectKeyTimestamp] = metric.GetTimestamp()
+		}
+		if metric.GetIsNull() {
+			metricObject[ObjectKeyIsNull] = true
+		} else {
+			value, err := metricValue(metric, dataType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert template metric '%s': %w", metric.GetName(), err)
+			}
+			metricObject[ObjectKeyValue] = value
+		}
+		metrics[i] = metricObject
+	}
+	object[ObjectKeyMetrics] = metrics
+
+	return object, nil
+}
+
+// ConvertDataSetToObject converts the Sparkplug DataSet to an EdgeX object value
+func ConvertDataSetToObject(dataSet *protobuf.Payload_DataSet) (map[string]any, error) {
+	if len(dataSet.GetTypes()) != len(dataSet.GetColumns()) {
+		return nil, fmt.Errorf("DataSet defines %d columns but %d types", len(dataSet.GetColumns()), len(dataSet.GetTypes()))
+	}
+
+	columns := make([]any, len(dataSet.GetColumns()))
+	types := make([]any, len(dataSet.GetTypes()))
+	for i, column := range dataSet.GetColumns() {
+		columns[i] = column
+		types[i] = protobuf.DataType(dataSet.GetTypes()[i]).String()
+	}
+
+	rows := make([]any, len(dataSet.GetRows()))
+	for i, row := range dataSet.GetRows() {
+		if len(row.GetElements()) != len(columns) {
+			return nil, fmt.Errorf("DataSet row %d has %d elements but %d columns", i, len(row.GetElements()), len(columns))
+		}
+		elements := make([]any, len(row.GetElements()))
+		for j, element := range row.GetElements() {
+			value, err := dataSetValue(element, protobuf.DataType(dataSet.GetTypes()[j]))
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert DataSet row %d column '%s': %w", i, dataSet.GetColumns()[j], err)
+			}
+			elements[j] = value
+		}
+		rows[i] = elements
+	}
+
+	return map[string]any{
+		ObjectKeyColumns: columns,
+		ObjectKeyTypes:   types,
+		ObjectKeyRows:    rows,
+	}, nil
+}
+
+// ConvertObjectToTemplate converts the EdgeX object value to a Sparkplug Template instance
+// Objects in the shape produced by ConvertTemplateToObject are converted back losslessly, while any other
+// object becomes a Template with one metric per key in key order and datatypes inferred from the values
+func ConvertObjectToTemplate(value any) (*protobuf.Payload_Template, error) {
+	object, err := toObjectMap(value)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := object[ObjectKeyMetrics].([]any); ok {
+		return objectToTemplate(object)
+	}
+	return inferTemplate(object)
+}
+
+// ConvertObjectToDataSet converts the EdgeX object value in the shape produced by ConvertDataSetToObject to a Sparkplug DataSet
+func ConvertObjectToDataSet(value any) (*protobuf.Payload_DataSet, error) {
+	object, err := toObjectMap(value)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := toStringSlice(object[ObjectKeyColumns])
+	if err != nil {
+		return nil, fmt.Errorf("invalid DataSet %s: %w", ObjectKeyColumns, err)
+	}
+	typeNames, err := toStringSlice(object[ObjectKeyTypes])
+	if err != nil {
+		return nil, fmt.Errorf("invalid DataSet %s: %w", ObjectKeyTypes, err)
+	}
+	if len(typeNames) != len(columns) {
+		return nil, fmt.Errorf("DataSet defines %d columns but %d types", len(columns), len(typeNames))
+	}
+	types := make([]uint32, len(typeNames))
+	for i, typeName := range typeNames {
+		dataType, err := parseDataTypeName(typeName)
+		if err != nil {
+			return nil, err
+		}
+		types[i] = uint32(dataType) // #nosec G115
+	}
+
+	rows, _ := object[ObjectKeyRows].([]any)
+	numOfColumns := uint64(len(columns))
+	dataSet := &protobuf.Payload_DataSet{
+		NumOfColumns: &numOfColumns,
+		Columns:      columns,
+		Types:        types,
+		Rows:         make([]*protobuf.Payload_DataSet_Row, len(rows)),
+	}
+	for i, row := range rows {
+		elements, ok := row.([]any)
+		if !ok || len(elements) != len(columns) {
+			return nil, fmt.Errorf("DataSet row %d must be an array of %d elements", i, len(columns))
+		}
+		dataSetRow := &protobuf.Payload_DataSet_Row{Elements: make([]*protobuf.Payload_DataSet_DataSetValue, len(elements))}
+		for j, element := range elements {
+			dataSetRow.Elements[j], err = toDataSetValue(protobuf.DataType(types[j]), element)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DataSet row %d column '%s': %w", i, columns[j], err)
+			}
+		}
+		dataSet.Rows[i] = dataSetRow
+	}
+	return dataSet, nil
+}
+
+// isDataSetObject checks if the object value is in the shape produced by ConvertDataSetToObject
+func isDataSetObject(value any) bool {
+	object, err := toObjectMap(value)
+	if err != nil {
+		return false
+	}
+	if _, hasMetrics := object[ObjectKeyMetrics]; hasMetrics {
+		return false
+	}
+	_, columnsErr := toStringSlice(object[ObjectKeyColumns])
+	_, typesErr := toStringSlice(object[ObjectKeyTypes])
+	return columnsErr == nil && typesErr == nil
+}
+
+func objectToTemplate(object map[string]any) (*protobuf.Payload_Template, error) {
+	template := &protobuf.Payload_Template{}
+	if templateRef, ok := object[ObjectKeyTemplateRef].(string); ok {
+		template.TemplateRef = &templateRef
+	}
+	if version, ok := object[ObjectKeyVersion].(string); ok {
+		template.Version = &version
+	}
+	if isDefinition, ok := object[ObjectKeyIsDefinition].(bool); ok {
+		template.IsDefinition = &isDefinition
+	}
+
+	parameters, _ := object[ObjectKeyParameters].([]any)
+	for i, item := range parameters {
+		parameterObject, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("template parameter %d must be an object", i)
+		}
+		name, dataType, err := nameAndDataType(parameterObject)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template parameter %d: %w", i, err)
+		}
+		parameter, err := toTemplateParameter(name, dataType, parameterObject[ObjectKeyValue])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template parameter '%s': %w", name, err)
+		}
+		template.Parameters = append(template.Parameters, parameter)
+	}
+
+	metrics, _ := object[ObjectKeyMetrics].([]any)
+	for i, item := range metrics {
+		metricObject, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("template metric %d must be an object", i)
+		}
+		name, dataType, err := nameAndDataType(metricObject)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template metric %d: %w", i, err)
+		}
+		datatype := uint32(dataType) // #nosec G115
+		metric := &protobuf.Payload_Metric{Name: &name, Datatype: &datatype}
+		if timestamp, ok := metricObject[ObjectKeyTimestamp]; ok {
+			converted, err := castScalarValue(protobuf.DataType_UInt64, timestamp)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp of template metric '%s': %w", name, err)
+			}
+			ts := converted.(uint64)
+			metric.Timestamp = &ts
+		}
+		if isNull, _ := metricObject[ObjectKeyIsNull].(bool); isNull || metricObject[ObjectKeyValue] == nil {
+			isNull = true
+			metric.IsNull = &isNull
+		} else if err = setLooseMetricValue(metric, dataType, metricObject[ObjectKeyValue]); err != nil {
+			return nil, fmt.Errorf("invalid value of template metric '%s': %w", name, err)
+		}
+		template.Metrics = append(template.Metrics, metric)
+	}
+	return template, nil
+}
+
+// inferTemplate converts a plain object to a Template, inferring the metric datatypes from the Go types of the values
+func inferTemplate(object map[string]any) (*protobuf.Payload_Template, error) {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	isDefinition := false
+	template := &protobuf.Payload_Template{IsDefinition: &isDefinition, Metrics: make([]*protobuf.Payload_Metric, len(keys))}
+	for i, key := range keys {
+		name := key
+		value := object[key]
+		dataType, err := inferDataType(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer the datatype of '%s': %w", key, err)
+		}
+		datatype := uint32(dataType) // #nosec G115
+		metric := &protobuf.Payload_Metric{Name: &name, Datatype: &datatype}
+		if value == nil {
+			isNull := true
+			metric.IsNull = &isNull
+		} else if err = setLooseMetricValue(metric, dataType, value); err != nil {
+			return nil, fmt.Errorf("invalid value of '%s': %w", key, err)
+		}
+		template.Metrics[i] = metric
+	}
+	return template, nil
+}
+
+// inferDataType returns the Sparkplug DataType matching the Go type of the value
+// JSON numbers are inferred as Int64 when integral and Double otherwise, and nil values as String
+func inferDataType(value any) (protobuf.DataType, error) {
+	switch v := value.(type) {
+	case nil, string:
+		return protobuf.DataType_String, nil
+	case bool:
+		return protobuf.DataType_Boolean, nil
+	case int8:
+		return protobuf.DataType_Int8, nil
+	case int16:
+		return protobuf.DataType_Int16, nil
+	case int32:
+		return protobuf.DataType_Int32, nil
+	case int, int64:
+		return protobuf.DataType_Int64, nil
+	case uint8:
+		return protobuf.DataType_UInt8, nil
+	case uint16:
+		return protobuf.DataType_UInt16, nil
+	case uint32:
+		return protobuf.DataType_UInt32, nil
+	case uint, uint64:
+		return protobuf.DataType_UInt64, nil
+	case float32:
+		return protobuf.DataType_Float, nil
+	case float64:
+		if v == float64(int64(v)) {
+			return protobuf.DataType_Int64, nil
+		}
+		return protobuf.DataType_Double, nil
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return protobuf.DataType_Int64, nil
+		}
+		return protobuf.DataType_Double, nil
+	case []byte:
+		return protobuf.DataType_Bytes, nil
+	case map[string]any:
+		if isDataSetObject(v) {
+			return protobuf.DataType_DataSet, nil
+		}
+		return protobuf.DataType_Template, nil
+	case []int8:
+		return protobuf.DataType_Int8Array, nil
+	case []int16:
+		return protobuf.DataType_Int16Array, nil
+	case []int32:
+		return protobuf.DataType_Int32Array, nil
+	case []int64:
+		return protobuf.DataType_Int64Array, nil
+	case []uint16:
+		return protobuf.DataType_UInt16Array, nil
+	case []uint32:
+		return protobuf.DataType_UInt32Array, nil
+	case []uint64:
+		return protobuf.DataType_UInt64Array, nil
+	case []float32:
+		return protobuf.DataType_FloatArray, nil
+	case []float64:
+		return protobuf.DataType_DoubleArray, nil
+	case []bool:
+		return protobuf.DataType_BooleanArray, nil
+	case []string:
+		return protobuf.DataType_StringArray, nil
+	case []any:
+		if len(v) == 0 {
+			return protobuf.DataType_StringArray, nil
+		}
+		elementType, err := inferDataType(v[0])
+		if err != nil {
+			return protobuf.DataType_Unknown, err
+		}
+		for _, element := range v[1:] {
+			if t, err := inferDataType(element); err != nil || t != elementType {
+				if elementType == protobuf.DataType_Int64 && t == protobuf.DataType_Double {
+					elementType = protobuf.DataType_Double
+					continue
+				}
+				if elementType == protobuf.DataType_Double && t == protobuf.DataType_Int64 {
+					continue
+				}
+				return protobuf.DataType_Unknown, fmt.Errorf("array elements must share the same type")
+			}
+		}
+		switch elementType {
+		case protobuf.DataType_Int64:
+			return protobuf.DataType_Int64Array, nil
+		case protobuf.DataType_Double:
+			return protobuf.DataType_DoubleArray, nil
+		case protobuf.DataType_Boolean:
+			return protobuf.DataType_BooleanArray, nil
+		case protobuf.DataType_String:
+			return protobuf.DataType_StringArray, nil
+		}
+	}
+	return protobuf.DataType_Unknown, fmt.Errorf("unsupported value type %T", value)
+}
+
+// setLooseMetricValue sets the metric value from a Go native or JSON decoded value of the DataType
+func setLooseMetricValue(metric *protobuf.Payload_Metric, dataType protobuf.DataType, value any) error {
+	switch dataType {
+	case protobuf.DataType_Template:
+		template, err := ConvertObjectToTemplate(value)
+		if err != nil {
+			return err
+		}
+		metric.Value = &protobuf.Payload_Metric_TemplateValue{TemplateValue: template}
+		return nil
+	case protobuf.DataType_DataSet:
+		dataSet, err := ConvertObjectToDataSet(value)
+		if err != nil {
+			return err
+		}
+		metric.Value = &protobuf.Payload_Metric_DatasetValue{DatasetValue: dataSet}
+		return nil
+	case protobuf.DataType_Bytes, protobuf.DataType_File:
+		data, err := toBytes(value)
+		if err != nil {
+			return err
+		}
+		metric.Value = &protobuf.Payload_Metric_BytesValue{BytesValue: data}
+		return nil
+	}
+
+	var converted any
+	var err error
+	if isArrayDataType(dataType) {
+		converted, err = castArrayValue(dataType, value)
+	} else {
+		converted, err = castScalarValue(dataType, value)
+	}
+	if err != nil {
+		return err
+	}
+	return setMetricValue(metric, dataType, converted)
+}
+
+// castArrayValue casts the Go native or JSON decoded array to the Go slice of the array DataType
+func castArrayValue(dataType protobuf.DataType, value any) (any, error) {
+	if s, ok := value.(string); ok {
+		return parseArrayValue(dataType, s)
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("invalid value type %T for DataType '%s'", value, dataType)
+	}
+	elements := make([]any, rv.Len())
+	for i := range elements {
+		elements[i] = rv.Index(i).Interface()
+	}
+	elementType := arrayElementDataType(dataType)
+
+	switch dataType {
+	case protobuf.DataType_Int8Array:
+		return castArrayElements[int8](elements, elementType)
+	case protobuf.DataType_Int16Array:
+		return castArrayElements[int16](elements, elementType)
+	case protobuf.DataType_Int32Array:
+		return castArrayElements[int32](elements, elementType)
+	case protobuf.DataType_Int64Array:
+		return castArrayElements[int64](elements, elementType)
+	case protobuf.DataType_UInt8Array:
+		return castArrayElements[uint8](elements, elementType)
+	case protobuf.DataType_UInt16Array:
+		return castArrayElements[uint16](elements, elementType)
+	case protobuf.DataType_UInt32Array:
+		return castArrayElements[uint32](elements, elementType)
+	case protobuf.DataType_UInt64Array, protobuf.DataType_DateTimeArray:
+		return castArrayElements[uint64](elements, elementType)
+	case protobuf.DataType_FloatArray:
+		return castArrayElements[float32](elements, elementType)
+	case protobuf.DataType_DoubleArray:
+		return castArrayElements[float64](elements, elementType)
+	case protobuf.DataType_BooleanArray:
+		return castArrayElements[bool](elements, elementType)
+	case protobuf.DataType_StringArray:
+		return castArrayElements[string](elements, elementType)
+	default:
+		return nil, fmt.Errorf("DataType '%s' is not an array type", dataType)
+	}
+}
+
+func castArrayElements[T any](elements []any, elementType protobuf.DataType) ([]T, error) {
+	values := make([]T, len(elements))
+	for i, element := range elements {
+		value, err := castScalarValue(elementType, element)
+		if err != nil {
+			return nil, fmt.Errorf("invalid array element %d: %w", i, err)
+		}
+		values[i] = value.(T)
+	}
+	return values, nil
+}
+
+// parameterValue returns the template parameter value as the Go type of the DataType
+func parameterValue(parameter *protobuf.Payload_Template_Parameter, dataType protobuf.DataType) (any, error) {
+	switch v := parameter.GetValue().(type) {
+	case nil:
+		return nil, nil
+	case *protobuf.Payload_Template_Parameter_IntValue:
+		return fromIntValue(dataType, v.IntValue), nil
+	case *protobuf.Payload_Template_Parameter_LongValue:
+		return fromLongValue(dataType, v.LongValue), nil
+	case *protobuf.Payload_Template_Parameter_FloatValue:
+		return v.FloatValue, nil
+	case *protobuf.Payload_Template_Parameter_DoubleValue:
+		return v.DoubleValue, nil
+	case *protobuf.Payload_Template_Parameter_BooleanValue:
+		return v.BooleanValue, nil
+	case *protobuf.Payload_Template_Parameter_StringValue:
+		return v.StringValue, nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter value %T", v)
+	}
+}
+
+// toTemplateParameter creates the template parameter from a Go native or JSON decoded value of the DataType
+func toTemplateParameter(name string, dataType protobuf.DataType, value any) (*protobuf.Payload_Template_Parameter, error) {
+	parameterType := uint32(dataType) // #nosec G115
+	parameter := &protobuf.Payload_Template_Parameter{Name: &name, Type: &parameterType}
+	if value == nil {
+		return parameter, nil
+	}
+	converted, err := castScalarValue(dataType, value)
+	if err != nil {
+		return nil, err
+	}
+	switch v := converted.(type) {
+	case int8, int16, int32, uint8, uint16, uint32:
+		parameter.Value = &protobuf.Payload_Template_Parameter_IntValue{IntValue: toIntValue(v)}
+	case int64:
+		parameter.Value = &protobuf.Payload_Template_Parameter_LongValue{LongValue: uint64(v)} // #nosec G115
+	case uint64:
+		parameter.Value = &protobuf.Payload_Template_Parameter_LongValue{LongValue: v}
+	case float32:
+		parameter.Value = &protobuf.Payload_Template_Parameter_FloatValue{FloatValue: v}
+	case float64:
+		parameter.Value = &protobuf.Payload_Template_Parameter_DoubleValue{DoubleValue: v}
+	case bool:
+		parameter.Value = &protobuf.Payload_Template_Parameter_BooleanValue{BooleanValue: v}
+	case string:
+		parameter.Value = &protobuf.Payload_Template_Parameter_StringValue{StringValue: v}
+	}
+	return parameter, nil
+}
+
+// dataSetValue returns the DataSet element value as the Go type of the DataType
+func dataSetValue(element *protobuf.Payload_DataSet_DataSetValue, dataType protobuf.DataType) (any, error) {
+	switch v := element.GetValue().(type) {
+	case nil:
+		return nil, nil
+	case *protobuf.Payload_DataSet_DataSetValue_IntValue:
+		return fromIntValue(dataType, v.IntValue), nil
+	case *protobuf.Payload_DataSet_DataSetValue_LongValue:
+		return fromLongValue(dataType, v.LongValue), nil
+	case *protobuf.Payload_DataSet_DataSetValue_FloatValue:
+		return v.FloatValue, nil
+	case *protobuf.Payload_DataSet_DataSetValue_DoubleValue:
+		return v.DoubleValue, nil
+	case *protobuf.Payload_DataSet_DataSetValue_BooleanValue:
+		return v.BooleanValue, nil
+	case *protobuf.Payload_DataSet_DataSetValue_StringValue:
+		return v.StringValue, nil
+	default:
+		return nil, fmt.Errorf("unsupported DataSet value %T", v)
+	}
+}
+
+// toDataSetValue creates the DataSet element from a Go native or JSON decoded value of the DataType
+func toDataSetValue(dataType protobuf.DataType, value any) (*protobuf.Payload_DataSet_DataSetValue, error) {
+	element := &protobuf.Payload_DataSet_DataSetValue{}
+	if value == nil {
+		return element, nil
+	}
+	converted, err := castScalarValue(dataType, value)
+	if err != nil {
+		return nil, err
+	}
+	switch v := converted.(type) {
+	case int8, int16, int32, uint8, uint16, uint32:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: toIntValue(v)}
+	case int64:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: uint64(v)} // #nosec G115
+	case uint64:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: v}
+	case float32:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_FloatValue{FloatValue: v}
+	case float64:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_DoubleValue{DoubleValue: v}
+	case bool:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_BooleanValue{BooleanValue: v}
+	case string:
+		element.Value = &protobuf.Payload_DataSet_DataSetValue_StringValue{StringValue: v}
+	}
+	return element, nil
+}
+
+// toIntValue converts the Go integer of at most 32 bits to the uint32 int_value, keeping the two's complement bits
+func toIntValue(value any) uint32 {
+	switch v := value.(type) {
+	case int8:
+		return uint32(v) // #nosec G115
+	case int16:
+		return uint32(v) // #nosec G115
+	case int32:
+		return uint32(v) // #nosec G115
+	case uint8:
+		return uint32(v)
+	case uint16:
+		return uint32(v)
+	case uint32:
+		return v
+	}
+	return 0
+}
+
+func nameAndDataType(object map[string]any) (string, protobuf.DataType, error) {
+	name, ok := object[ObjectKeyName].(string)
+	if !ok || name == "" {
+		return "", protobuf.DataType_Unknown, fmt.Errorf("%s is required", ObjectKeyName)
+	}
+	typeName, ok := object[ObjectKeyDataType].(string)
+	if !ok {
+		return "", protobuf.DataType_Unknown, fmt.Errorf("%s of '%s' is required", ObjectKeyDataType, name)
+	}
+	dataType, err := parseDataTypeName(typeName)
+	return name, dataType, err
+}
+
+// parseDataTypeName returns the DataType of the name, e.g. "Int32"
+func parseDataTypeName(name string) (protobuf.DataType, error) {
+	value, ok := protobuf.DataType_value[name]
+	if !ok || value == int32(protobuf.DataType_Unknown) {
+		return protobuf.DataType_Unknown, fmt.Errorf("unknown Sparkplug DataType '%s'", name)
+	}
+	return protobuf.DataType(value), nil
+}
+
+// toObjectMap returns the object value as map[string]any, converting other Go values through JSON
+func toObjectMap(value any) (map[string]any, error) {
+	if object, ok := value.(map[string]any); ok {
+		return object, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the object value: %w", err)
+	}
+	var object map[string]any
+	if err = json.Unmarshal(data, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("object value %T is not a JSON object", value)
+	}
+	return object, nil
+}
+
+func toStringSlice(value any) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		values := make([]string, len(v))
+		for i, element := range v {
+			s, ok := element.(string)
+			if !ok {
+				return nil, fmt.Errorf("element %d must be a string", i)
+			}
+			values[i] = s
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("must be an array of strings")
+}
+
+// toBytes returns the Bytes value, decoding the base64 string produced when []byte is marshaled to JSON
+func toBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return nil, fmt.Errorf("invalid value type %T for bytes", value)
+}
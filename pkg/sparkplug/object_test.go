@@ -0,0 +1,176 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestDataSet() *protobuf.Payload_DataSet {
+	return &protobuf.Payload_DataSet{
+		NumOfColumns: proto.Uint64(3),
+		Columns:      []string{"id", "label", "enabled"},
+		Types:        []uint32{uint32(protobuf.DataType_Int32), uint32(protobuf.DataType_String), uint32(protobuf.DataType_Boolean)},
+		Rows: []*protobuf.Payload_DataSet_Row{
+			{Elements: []*protobuf.Payload_DataSet_DataSetValue{
+				{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(0xFFFFFFFF)}},
+				{Value: &protobuf.Payload_DataSet_DataSetValue_StringValue{StringValue: "a"}},
+				{Value: &protobuf.Payload_DataSet_DataSetValue_BooleanValue{BooleanValue: true}},
+			}},
+			{Elements: []*protobuf.Payload_DataSet_DataSetValue{
+				{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: 2}},
+				{},
+				{Value: &protobuf.Payload_DataSet_DataSetValue_BooleanValue{BooleanValue: false}},
+			}},
+		},
+	}
+}
+
+func newTestTemplate(t *testing.T) *protobuf.Payload_Template {
+	nested := &protobuf.Payload_Template{
+		TemplateRef:  proto.String("Bearing"),
+		IsDefinition: proto.Bool(false),
+		Metrics: []*protobuf.Payload_Metric{
+			{Name: proto.String("temperature"), Datatype: proto.Uint32(uint32(protobuf.DataType_Float)), Value: &protobuf.Payload_Metric_FloatValue{FloatValue: 40.5}},
+		},
+	}
+	return &protobuf.Payload_Template{
+		TemplateRef:  proto.String("Motor"),
+		Version:      proto.String("1.0"),
+		IsDefinition: proto.Bool(false),
+		Parameters: []*protobuf.Payload_Template_Parameter{
+			{Name: proto.String("rated"), Type: proto.Uint32(uint32(protobuf.DataType_Double)), Value: &protobuf.Payload_Template_Parameter_DoubleValue{DoubleValue: 1.5}},
+			{Name: proto.String("poles"), Type: proto.Uint32(uint32(protobuf.DataType_Int16)), Value: &protobuf.Payload_Template_Parameter_IntValue{IntValue: uint32(0xFFFFFFFE)}},
+			{Name: proto.String("serial"), Type: proto.Uint32(uint32(protobuf.DataType_String))},
+		},
+		Metrics: []*protobuf.Payload_Metric{
+			{Name: proto.String("speed"), Timestamp: proto.Uint64(1700000000000), Datatype: proto.Uint32(uint32(protobuf.DataType_Int64)), Value: &protobuf.Payload_Metric_LongValue{LongValue: 1200}},
+			{Name: proto.String("running"), Datatype: proto.Uint32(uint32(protobuf.DataType_Boolean)), Value: &protobuf.Payload_Metric_BooleanValue{BooleanValue: true}},
+			{Name: proto.String("fault"), Datatype: proto.Uint32(uint32(protobuf.DataType_String)), IsNull: proto.Bool(true)},
+			{Name: proto.String("raw"), Datatype: proto.Uint32(uint32(protobuf.DataType_Bytes)), Value: &protobuf.Payload_Metric_BytesValue{BytesValue: []byte{0, 1, 2}}},
+			newTestArrayMetric(t, protobuf.DataType_Int32Array, []int32{-1, 2}),
+			{Name: proto.String("bearing"), Datatype: proto.Uint32(uint32(protobuf.DataType_Template)), Value: &protobuf.Payload_Metric_TemplateValue{TemplateValue: nested}},
+			{Name: proto.String("history"), Datatype: proto.Uint32(uint32(protobuf.DataType_DataSet)), Value: &protobuf.Payload_Metric_DatasetValue{DatasetValue: newTestDataSet()}},
+		},
+	}
+}
+
+func TestTemplateObjectRoundTrip(t *testing.T) {
+	template := newTestTemplate(t)
+	object, err := ConvertTemplateToObject(template)
+	require.NoError(t, err)
+	assert.Equal(t, "Motor", object[ObjectKeyTemplateRef])
+	require.Len(t, object[ObjectKeyParameters], 3)
+	require.Len(t, object[ObjectKeyMetrics], 7)
+	poles := object[ObjectKeyParameters].([]any)[1].(map[string]any)
+	assert.Equal(t, int16(-2), poles[ObjectKeyValue])
+
+	result, err := ConvertObjectToTemplate(object)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(template, result), "expected %v, got %v", template, result)
+
+	// the object read back from a JSON encoded event must convert to the same template
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	result, err = ConvertObjectToTemplate(decoded)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(template, result), "expected %v, got %v", template, result)
+}
+
+func TestTemplateObjectShape(t *testing.T) {
+	object, err := ConvertTemplateToObject(newTestTemplate(t))
+	require.NoError(t, err)
+	data, err := json.Marshal(object[ObjectKeyMetrics].([]any)[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"speed","dataType":"Int64","value":1200,"timestamp":1700000000000}`, string(data))
+
+	object, err = ConvertDataSetToObject(newTestDataSet())
+	require.NoError(t, err)
+	data, err = json.Marshal(object)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"columns":["id","label","enabled"],"types":["Int32","String","Boolean"],"rows":[[-1,"a",true],[2,null,false]]}`, string(data))
+}
+
+func TestDataSetObjectRoundTrip(t *testing.T) {
+	dataSet := newTestDataSet()
+	object, err := ConvertDataSetToObject(dataSet)
+	require.NoError(t, err)
+	assert.True(t, isDataSetObject(object))
+
+	result, err := ConvertObjectToDataSet(object)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(dataSet, result), "expected %v, got %v", dataSet, result)
+}
+
+func TestConvertObjectToTemplate_Infer(t *testing.T) {
+	var object map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"speed": 10, "ratio": 0.5, "name": "m1", "on": true, "tags": ["a", "b"], "nested": {"level": 3}, "none": null}`), &object))
+
+	template, err := ConvertObjectToTemplate(object)
+	require.NoError(t, err)
+	require.Len(t, template.GetMetrics(), 7)
+
+	expected := map[string]protobuf.DataType{
+		"name":   protobuf.DataType_String,
+		"nested": protobuf.DataType_Template,
+		"none":   protobuf.DataType_String,
+		"on":     protobuf.DataType_Boolean,
+		"ratio":  protobuf.DataType_Double,
+		"speed":  protobuf.DataType_Int64,
+		"tags":   protobuf.DataType_StringArray,
+	}
+	for _, metric := range template.GetMetrics() {
+		assert.Equal(t, uint32(expected[metric.GetName()]), metric.GetDatatype(), metric.GetName())
+	}
+	assert.Equal(t, "name", template.GetMetrics()[0].GetName())
+	assert.True(t, template.GetMetrics()[2].GetIsNull())
+	assert.Equal(t, uint64(10), template.GetMetrics()[5].GetLongValue())
+
+	_, err = ConvertObjectToTemplate(map[string]any{"mixed": []any{1.0, "a"}})
+	require.Error(t, err)
+	_, err = ConvertObjectToTemplate("not an object")
+	require.Error(t, err)
+}
+
+func TestObjectMetricReadingRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *protobuf.Payload_Metric
+	}{
+		{"Template", newTestMetric(protobuf.DataType_Template, &protobuf.Payload_Metric_TemplateValue{TemplateValue: newTestTemplate(t)})},
+		{"DataSet", newTestMetric(protobuf.DataType_DataSet, &protobuf.Payload_Metric_DatasetValue{DatasetValue: newTestDataSet()})},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reading, err := ConvertMetricToReading(testCase.metric, testProfileName, testDeviceName, nil)
+			require.NoError(t, err)
+			assert.Equal(t, edgexCommon.ValueTypeObject, reading.ValueType)
+
+			result, err := ConvertReadingToMetric(reading)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(testCase.metric, result), "expected %v, got %v", testCase.metric, result)
+		})
+	}
+}
+
+func TestConvertReadingToMetric_PlainObject(t *testing.T) {
+	reading := dtos.NewObjectReading(testProfileName, testDeviceName, "motor", map[string]any{"speed": int32(5)})
+	metric, err := ConvertReadingToMetric(reading)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(protobuf.DataType_Template), metric.GetDatatype())
+	require.Len(t, metric.GetTemplateValue().GetMetrics(), 1)
+	assert.Equal(t, uint32(protobuf.DataType_Int32), metric.GetTemplateValue().GetMetrics()[0].GetDatatype())
+	assert.Equal(t, uint32(5), metric.GetTemplateValue().GetMetrics()[0].GetIntValue())
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+)
+
+// Well-known metric property keys defined by the Sparkplug B specification and commonly used by edge nodes
+const (
+	PropertyEngUnit     = "engUnit"
+	PropertyEngLow      = "engLow"
+	PropertyEngHigh     = "engHigh"
+	PropertyDescription = "description"
+	PropertyReadOnly    = "readOnly"
+	PropertyWritable    = "writable"
+	PropertyQuality     = "Quality"
+)
+
+// LookupProperty returns the value of the property key as the Go type of its DataType
+// Null properties are reported as found with a nil value, and nested property sets are returned as
+// *protobuf.Payload_PropertySet or *protobuf.Payload_PropertySetList
+func LookupProperty(properties *protobuf.Payload_PropertySet, key string) (any, bool) {
+	for i, k := range properties.GetKeys() {
+		if k != key {
+			continue
+		}
+		if i >= len(properties.GetValues()) {
+			return nil, false
+		}
+		return propertyValue(properties.GetValues()[i]), true
+	}
+	return nil, false
+}
+
+func propertyValue(value *protobuf.Payload_PropertyValue) any {
+	if value.GetIsNull() {
+		return nil
+	}
+	dataType := protobuf.DataType(value.GetType())
+	switch v := value.GetValue().(type) {
+	case *protobuf.Payload_PropertyValue_IntValue:
+		return fromIntValue(dataType, v.IntValue)
+	case *protobuf.Payload_PropertyValue_LongValue:
+		return fromLongValue(dataType, v.LongValue)
+	case *protobuf.Payload_PropertyValue_FloatValue:
+		return v.FloatValue
+	case *protobuf.Payload_PropertyValue_DoubleValue:
+		return v.DoubleValue
+	case *protobuf.Payload_PropertyValue_BooleanValue:
+		return v.BooleanValue
+	case *protobuf.Payload_PropertyValue_StringValue:
+		return v.StringValue
+	case *protobuf.Payload_PropertyValue_PropertysetValue:
+		return v.PropertysetValue
+	case *protobuf.Payload_PropertyValue_PropertysetsValue:
+		return v.PropertysetsValue
+	default:
+		return nil
+	}
+}
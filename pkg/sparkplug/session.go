@@ -0,0 +1,225 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+)
+
+const (
+	// BdSeqMetricName is the metric carrying the birth/death sequence number in NBIRTH and NDEATH payloads
+	BdSeqMetricName = "bdSeq"
+	// RebirthMetricName is the NCMD metric requesting an edge node to publish its NBIRTH and DBIRTH messages again
+	RebirthMetricName = "Node Control/Rebirth"
+
+	// seqModulus is the number of distinct values of the payload seq, which rolls over from 255 to 0
+	seqModulus = 256
+)
+
+// ApplyResult describes the outcome of applying a payload to the SessionStore
+type ApplyResult struct {
+	// Aliases resolves the metrics of the applied payload and may be nil if the birth message has not been received
+	Aliases *AliasTable
+	// SequenceGap reports that the payload seq does not follow the previous message of the edge node
+	SequenceGap bool
+	// StaleDeath reports that an NDEATH does not match the bdSeq of the current session and has been ignored
+	StaleDeath bool
+	// RebirthRequired reports that the session state is unreliable and a rebirth request should be sent to the edge node
+	RebirthRequired bool
+}
+
+// nodeSession is the state of an edge node session established by NBIRTH
+type nodeSession struct {
+	online        bool
+	bdSeq         uint64
+	seq           uint64
+	aliases       *AliasTable
+	deviceAliases map[string]*AliasTable
+}
+
+// SessionStore tracks the Sparkplug edge node sessions by node id and is safe for concurrent use
+type SessionStore struct {
+	mutex    sync.RWMutex
+	sessions map[string]*nodeSession
+}
+
+// NewSessionStore creates an empty SessionStore
+func NewSessionStore() *SessionStore {
+	return &SessionStore{sessions: make(map[string]*nodeSession)}
+}
+
+// Apply updates the edge node session with the payload received on the topic
+// Command and STATE messages are not part of an edge node session and leave the store unchanged
+func (s *SessionStore) Apply(topic Topic, payload *protobuf.Payload) (ApplyResult, error) {
+	if err := topic.Validate(); err != nil {
+		return ApplyResult{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nodeId := topic.NodeId()
+	session := s.sessions[nodeId]
+	var result ApplyResult
+
+	switch topic.MessageType {
+	case MessageTypeNBIRTH:
+		bdSeq, err := findBdSeq(payload)
+		if err != nil {
+			return ApplyResult{}, fmt.Errorf("invalid NBIRTH from '%s': %w", nodeId, err)
+		}
+		if payload.Seq == nil || payload.GetSeq() >= seqModulus {
+			return ApplyResult{}, fmt.Errorf("invalid NBIRTH from '%s': seq must be in the range 0-255", nodeId)
+		}
+		session = &nodeSession{
+			online:        true,
+			bdSeq:         bdSeq,
+			seq:           payload.GetSeq(),
+			aliases:       NewAliasTable(payload),
+			deviceAliases: make(map[string]*AliasTable),
+		}
+		s.sessions[nodeId] = session
+		result.Aliases = session.aliases
+		return result, nil
+	case MessageTypeNDEATH:
+		bdSeq, err := findBdSeq(payload)
+		if err != nil {
+			return ApplyResult{}, fmt.Errorf("invalid NDEATH from '%s': %w", nodeId, err)
+		}
+		if session == nil || !session.online || session.bdSeq != bdSeq {
+			result.StaleDeath = true
+			return result, nil
+		}
+		session.online = false
+		session.deviceAliases = make(map[string]*AliasTable)
+		return result, nil
+	case MessageTypeNDATA, MessageTypeDBIRTH, MessageTypeDDATA, MessageTypeDDEATH:
+		if session == nil || !session.online {
+			result.RebirthRequired = true
+			return result, nil
+		}
+	default:
+		return result, nil
+	}
+
+	if payload.Seq == nil || payload.GetSeq() >= seqModulus {
+		return ApplyResult{}, fmt.Errorf("invalid %s from '%s': seq must be in the range 0-255", topic.MessageType, nodeId)
+	}
+	if payload.GetSeq() != (session.seq+1)%seqModulus {
+		result.SequenceGap = true
+		result.RebirthRequired = true
+	}
+	session.seq = payload.GetSeq()
+
+	switch topic.MessageType {
+	case MessageTypeNDATA:
+		result.Aliases = session.aliases
+	case MessageTypeDBIRTH:
+		session.deviceAliases[topic.DeviceId] = NewAliasTable(payload)
+		result.Aliases = session.deviceAliases[topic.DeviceId]
+	case MessageTypeDDATA:
+		result.Aliases = session.deviceAliases[topic.DeviceId]
+		if result.Aliases == nil {
+			result.RebirthRequired = true
+		}
+	case MessageTypeDDEATH:
+		delete(session.deviceAliases, topic.DeviceId)
+	}
+
+	if result.Aliases != nil && topic.MessageType != MessageTypeDBIRTH {
+		for _, metric := range payload.GetMetrics() {
+			if _, err := result.Aliases.Resolve(metric); err != nil {
+				result.RebirthRequired = true
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// Aliases returns the alias table declared by the birth message of the edge node or device of the topic
+func (s *SessionStore) Aliases(topic Topic) (*AliasTable, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	session, ok := s.sessions[topic.NodeId()]
+	if !ok || !session.online {
+		return nil, false
+	}
+	if topic.MessageType.IsDeviceMessage() {
+		aliases, ok := session.deviceAliases[topic.DeviceId]
+		return aliases, ok
+	}
+	return session.aliases, true
+}
+
+// Resolve returns the name and datatype of the metric received on the topic
+func (s *SessionStore) Resolve(topic Topic, metric *protobuf.Payload_Metric) (MetricDefinition, error) {
+	aliases, ok := s.Aliases(topic)
+	if !ok {
+		return MetricDefinition{}, fmt.Errorf("no birth message received for topic '%s'", topic)
+	}
+	return aliases.Resolve(metric)
+}
+
+// Online checks if the edge node of the node id has an active session
+func (s *SessionStore) Online(nodeId string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	session, ok := s.sessions[nodeId]
+	return ok && session.online
+}
+
+// Remove deletes the session of the edge node of the node id
+func (s *SessionStore) Remove(nodeId string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.sessions, nodeId)
+}
+
+// findBdSeq returns the value of the bdSeq metric of the NBIRTH or NDEATH payload
+func findBdSeq(payload *protobuf.Payload) (uint64, error) {
+	for _, metric := range payload.GetMetrics() {
+		if metric.GetName() != BdSeqMetricName {
+			continue
+		}
+		switch value := metric.GetValue().(type) {
+		case *protobuf.Payload_Metric_LongValue:
+			return value.LongValue, nil
+		case *protobuf.Payload_Metric_IntValue:
+			return uint64(value.IntValue), nil
+		default:
+			return 0, fmt.Errorf("metric '%s' must be an integer", BdSeqMetricName)
+		}
+	}
+	return 0, fmt.Errorf("metric '%s' is required", BdSeqMetricName)
+}
+
+// NewRebirthCommand creates the NCMD topic and payload requesting the edge node of the topic to rebirth
+func NewRebirthCommand(topic Topic) (Topic, *protobuf.Payload, error) {
+	command, err := NewNodeTopic(topic.GroupId, MessageTypeNCMD, topic.EdgeNodeId)
+	if err != nil {
+		return Topic{}, nil, err
+	}
+	timestamp := uint64(time.Now().UnixMilli()) // #nosec G115
+	datatype := uint32(protobuf.DataType_Boolean)
+	name := RebirthMetricName
+	payload := &protobuf.Payload{
+		Timestamp: &timestamp,
+		Metrics: []*protobuf.Payload_Metric{
+			{
+				Name:      &name,
+				Timestamp: &timestamp,
+				Datatype:  &datatype,
+				Value:     &protobuf.Payload_Metric_BooleanValue{BooleanValue: true},
+			},
+		},
+	}
+	return command, payload, nil
+}
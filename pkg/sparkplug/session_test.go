@@ -0,0 +1,193 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestBdSeqPayload(bdSeq uint64, seq *uint64, metrics ...*protobuf.Payload_Metric) *protobuf.Payload {
+	bdSeqMetric := &protobuf.Payload_Metric{
+		Name:     proto.String(BdSeqMetricName),
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Int64)),
+		Value:    &protobuf.Payload_Metric_LongValue{LongValue: bdSeq},
+	}
+	return &protobuf.Payload{Seq: seq, Metrics: append([]*protobuf.Payload_Metric{bdSeqMetric}, metrics...)}
+}
+
+func newTestAliasMetric(alias uint64) *protobuf.Payload_Metric {
+	return &protobuf.Payload_Metric{Alias: proto.Uint64(alias), Value: &protobuf.Payload_Metric_DoubleValue{DoubleValue: 1}}
+}
+
+func mustParseTopic(t *testing.T, topic string) Topic {
+	result, err := ParseTopic(topic)
+	require.NoError(t, err)
+	return result
+}
+
+func TestSessionStore_Lifecycle(t *testing.T) {
+	store := NewSessionStore()
+	nbirth := mustParseTopic(t, "spBv1.0/group/NBIRTH/node")
+	ndata := mustParseTopic(t, "spBv1.0/group/NDATA/node")
+	dbirth := mustParseTopic(t, "spBv1.0/group/DBIRTH/node/device")
+	ddata := mustParseTopic(t, "spBv1.0/group/DDATA/node/device")
+	ddeath := mustParseTopic(t, "spBv1.0/group/DDEATH/node/device")
+	ndeath := mustParseTopic(t, "spBv1.0/group/NDEATH/node")
+
+	result, err := store.Apply(nbirth, newTestBdSeqPayload(3, proto.Uint64(0), &protobuf.Payload_Metric{
+		Name: proto.String("temperature"), Alias: proto.Uint64(1), Datatype: proto.Uint32(uint32(protobuf.DataType_Double)),
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.RebirthRequired)
+	assert.True(t, store.Online("group/node"))
+
+	result, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(1), Metrics: []*protobuf.Payload_Metric{newTestAliasMetric(1)}})
+	require.NoError(t, err)
+	assert.False(t, result.SequenceGap)
+	assert.False(t, result.RebirthRequired)
+	definition, err := result.Aliases.Resolve(newTestAliasMetric(1))
+	require.NoError(t, err)
+	assert.Equal(t, MetricDefinition{Name: "temperature", DataType: protobuf.DataType_Double}, definition)
+
+	result, err = store.Apply(dbirth, &protobuf.Payload{Seq: proto.Uint64(2), Metrics: []*protobuf.Payload_Metric{
+		{Name: proto.String("pressure"), Alias: proto.Uint64(1), Datatype: proto.Uint32(uint32(protobuf.DataType_Float))},
+	}})
+	require.NoError(t, err)
+	assert.False(t, result.RebirthRequired)
+
+	result, err = store.Apply(ddata, &protobuf.Payload{Seq: proto.Uint64(3), Metrics: []*protobuf.Payload_Metric{newTestAliasMetric(1)}})
+	require.NoError(t, err)
+	assert.False(t, result.RebirthRequired)
+	definition, err = store.Resolve(ddata, newTestAliasMetric(1))
+	require.NoError(t, err)
+	assert.Equal(t, "pressure", definition.Name)
+
+	result, err = store.Apply(ddeath, &protobuf.Payload{Seq: proto.Uint64(4)})
+	require.NoError(t, err)
+	assert.False(t, result.RebirthRequired)
+	_, ok := store.Aliases(ddata)
+	assert.False(t, ok)
+
+	result, err = store.Apply(ndeath, newTestBdSeqPayload(2, nil))
+	require.NoError(t, err)
+	assert.True(t, result.StaleDeath)
+	assert.True(t, store.Online("group/node"))
+
+	result, err = store.Apply(ndeath, newTestBdSeqPayload(3, nil))
+	require.NoError(t, err)
+	assert.False(t, result.StaleDeath)
+	assert.False(t, store.Online("group/node"))
+
+	result, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(5)})
+	require.NoError(t, err)
+	assert.True(t, result.RebirthRequired)
+
+	store.Remove("group/node")
+	result, err = store.Apply(ndeath, newTestBdSeqPayload(3, nil))
+	require.NoError(t, err)
+	assert.True(t, result.StaleDeath)
+}
+
+func TestSessionStore_SequenceRollover(t *testing.T) {
+	store := NewSessionStore()
+	nbirth := mustParseTopic(t, "spBv1.0/group/NBIRTH/node")
+	ndata := mustParseTopic(t, "spBv1.0/group/NDATA/node")
+
+	_, err := store.Apply(nbirth, newTestBdSeqPayload(0, proto.Uint64(254)))
+	require.NoError(t, err)
+	result, err := store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(255)})
+	require.NoError(t, err)
+	assert.False(t, result.SequenceGap)
+	result, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(0)})
+	require.NoError(t, err)
+	assert.False(t, result.SequenceGap)
+
+	result, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(2)})
+	require.NoError(t, err)
+	assert.True(t, result.SequenceGap)
+	assert.True(t, result.RebirthRequired)
+	result, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(3)})
+	require.NoError(t, err)
+	assert.False(t, result.SequenceGap)
+
+	_, err = store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(256)})
+	require.Error(t, err)
+	_, err = store.Apply(ndata, &protobuf.Payload{})
+	require.Error(t, err)
+}
+
+func TestSessionStore_RebirthRequired(t *testing.T) {
+	tests := []struct {
+		name    string
+		topic   string
+		payload *protobuf.Payload
+	}{
+		{"unknown node", "spBv1.0/other/NDATA/node", &protobuf.Payload{Seq: proto.Uint64(1)}},
+		{"unknown device", "spBv1.0/group/DDATA/node/unknown", &protobuf.Payload{Seq: proto.Uint64(1)}},
+		{"undeclared alias", "spBv1.0/group/NDATA/node", &protobuf.Payload{Seq: proto.Uint64(1), Metrics: []*protobuf.Payload_Metric{newTestAliasMetric(9)}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := NewSessionStore()
+			_, err := store.Apply(mustParseTopic(t, "spBv1.0/group/NBIRTH/node"), newTestBdSeqPayload(0, proto.Uint64(0)))
+			require.NoError(t, err)
+
+			result, err := store.Apply(mustParseTopic(t, testCase.topic), testCase.payload)
+			require.NoError(t, err)
+			assert.True(t, result.RebirthRequired)
+		})
+	}
+}
+
+func TestSessionStore_InvalidBirth(t *testing.T) {
+	store := NewSessionStore()
+	nbirth := mustParseTopic(t, "spBv1.0/group/NBIRTH/node")
+
+	_, err := store.Apply(nbirth, &protobuf.Payload{Seq: proto.Uint64(0)})
+	require.Error(t, err)
+	_, err = store.Apply(nbirth, newTestBdSeqPayload(0, nil))
+	require.Error(t, err)
+	_, err = store.Apply(mustParseTopic(t, "spBv1.0/group/NDEATH/node"), &protobuf.Payload{})
+	require.Error(t, err)
+	assert.False(t, store.Online("group/node"))
+}
+
+func TestSessionStore_Concurrent(t *testing.T) {
+	store := NewSessionStore()
+	var wg sync.WaitGroup
+	for _, node := range []string{"a", "b", "c", "d"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nbirth, _ := NewNodeTopic("group", MessageTypeNBIRTH, node)
+			ndata, _ := NewNodeTopic("group", MessageTypeNDATA, node)
+			_, err := store.Apply(nbirth, newTestBdSeqPayload(0, proto.Uint64(0)))
+			assert.NoError(t, err)
+			for seq := uint64(1); seq < 600; seq++ {
+				result, err := store.Apply(ndata, &protobuf.Payload{Seq: proto.Uint64(seq % seqModulus)})
+				assert.NoError(t, err)
+				assert.False(t, result.SequenceGap)
+				store.Online(ndata.NodeId())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewRebirthCommand(t *testing.T) {
+	topic, payload, err := NewRebirthCommand(mustParseTopic(t, "spBv1.0/group/DDATA/node/device"))
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/group/NCMD/node", topic.String())
+	require.Len(t, payload.GetMetrics(), 1)
+	metric := payload.GetMetrics()[0]
+	assert.Equal(t, RebirthMetricName, metric.GetName())
+	assert.Equal(t, uint32(protobuf.DataType_Boolean), metric.GetDatatype())
+	assert.True(t, metric.GetBooleanValue())
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/models"
+)
+
+// Namespace is the first element of every Sparkplug B topic
+const Namespace = "spBv1.0"
+
+const topicSeparator = "/"
+
+// MessageType is the Sparkplug message type element of a topic
+type MessageType string
+
+const (
+	MessageTypeNBIRTH MessageType = "NBIRTH"
+	MessageTypeNDEATH MessageType = "NDEATH"
+	MessageTypeNDATA  MessageType = "NDATA"
+	MessageTypeNCMD   MessageType = "NCMD"
+	MessageTypeDBIRTH MessageType = "DBIRTH"
+	MessageTypeDDEATH MessageType = "DDEATH"
+	MessageTypeDDATA  MessageType = "DDATA"
+	MessageTypeDCMD   MessageType = "DCMD"
+	MessageTypeSTATE  MessageType = "STATE"
+)
+
+// IsValid checks if the message type is defined by the Sparkplug B specification
+func (m MessageType) IsValid() bool {
+	return m.IsNodeMessage() || m.IsDeviceMessage() || m == MessageTypeSTATE
+}
+
+// IsNodeMessage checks if the message type is published on an edge node topic without a device
+func (m MessageType) IsNodeMessage() bool {
+	switch m {
+	case MessageTypeNBIRTH, MessageTypeNDEATH, MessageTypeNDATA, MessageTypeNCMD:
+		return true
+	}
+	return false
+}
+
+// IsDeviceMessage checks if the message type is published on a device topic
+func (m MessageType) IsDeviceMessage() bool {
+	switch m {
+	case MessageTypeDBIRTH, MessageTypeDDEATH, MessageTypeDDATA, MessageTypeDCMD:
+		return true
+	}
+	return false
+}
+
+// Topic represents a Sparkplug B topic
+// Edge node and device topics are spBv1.0/{GroupId}/{MessageType}/{EdgeNodeId}[/{DeviceId}],
+// while the host application state topic is spBv1.0/STATE/{HostId}
+type Topic struct {
+	GroupId     string
+	MessageType MessageType
+	EdgeNodeId  string
+	DeviceId    string
+	HostId      string
+}
+
+// NewNodeTopic creates the edge node topic of the given message type
+func NewNodeTopic(groupId string, messageType MessageType, edgeNodeId string) (Topic, error) {
+	topic := Topic{GroupId: groupId, MessageType: messageType, EdgeNodeId: edgeNodeId}
+	if !messageType.IsNodeMessage() {
+		return Topic{}, fmt.Errorf("message type '%s' is not an edge node message type", messageType)
+	}
+	return topic, topic.Validate()
+}
+
+// NewDeviceTopic creates the device topic of the given message type
+func NewDeviceTopic(groupId string, messageType MessageType, edgeNodeId, deviceId string) (Topic, error) {
+	topic := Topic{GroupId: groupId, MessageType: messageType, EdgeNodeId: edgeNodeId, DeviceId: deviceId}
+	if !messageType.IsDeviceMessage() {
+		return Topic{}, fmt.Errorf("message type '%s' is not a device message type", messageType)
+	}
+	return topic, topic.Validate()
+}
+
+// NewStateTopic creates the state topic of the given host application
+func NewStateTopic(hostId string) (Topic, error) {
+	topic := Topic{MessageType: MessageTypeSTATE, HostId: hostId}
+	return topic, topic.Validate()
+}
+
+// ParseTopic parses and validates the Sparkplug B topic
+func ParseTopic(topic string) (Topic, error) {
+	elements := strings.Split(topic, topicSeparator)
+	if elements[0] != Namespace {
+		return Topic{}, fmt.Errorf("topic '%s' does not start with the namespace '%s'", topic, Namespace)
+	}
+
+	var result Topic
+	switch {
+	case len(elements) == 3 && MessageType(elements[1]) == MessageTypeSTATE:
+		result = Topic{MessageType: MessageTypeSTATE, HostId: elements[2]}
+	case len(elements) == 4:
+		result = Topic{GroupId: elements[1], MessageType: MessageType(elements[2]), EdgeNodeId: elements[3]}
+	case len(elements) == 5:
+		result = Topic{GroupId: elements[1], MessageType: MessageType(elements[2]), EdgeNodeId: elements[3], DeviceId: elements[4]}
+	default:
+		return Topic{}, fmt.Errorf("topic '%s' has an invalid number of elements", topic)
+	}
+
+	if err := result.Validate(); err != nil {
+		return Topic{}, fmt.Errorf("invalid topic '%s': %w", topic, err)
+	}
+	return result, nil
+}
+
+// Validate checks the message type and that the topic defines exactly the ids required by it
+func (t Topic) Validate() error {
+	if !t.MessageType.IsValid() {
+		return fmt.Errorf("unknown message type '%s'", t.MessageType)
+	}
+
+	if t.MessageType == MessageTypeSTATE {
+		if t.GroupId != "" || t.EdgeNodeId != "" || t.DeviceId != "" {
+			return fmt.Errorf("STATE topic must only define the host id")
+		}
+		return validateTopicId("host id", t.HostId)
+	}
+
+	if t.HostId != "" {
+		return fmt.Errorf("message type '%s' must not define the host id", t.MessageType)
+	}
+	if err := validateTopicId("group id", t.GroupId); err != nil {
+		return err
+	}
+	if err := validateTopicId("edge node id", t.EdgeNodeId); err != nil {
+		return err
+	}
+	if t.MessageType.IsDeviceMessage() {
+		return validateTopicId("device id", t.DeviceId)
+	}
+	if t.DeviceId != "" {
+		return fmt.Errorf("message type '%s' must not define the device id", t.MessageType)
+	}
+	return nil
+}
+
+// validateTopicId checks that the id is a single non-empty topic level without MQTT wildcards
+func validateTopicId(name, id string) error {
+	if id == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+	if strings.ContainsAny(id, "/+#") {
+		return fmt.Errorf("%s '%s' must not contain '/', '+' or '#'", name, id)
+	}
+	return nil
+}
+
+// String returns the topic string
+func (t Topic) String() string {
+	if t.MessageType == MessageTypeSTATE {
+		return strings.Join([]string{Namespace, string(MessageTypeSTATE), t.HostId}, topicSeparator)
+	}
+	elements := []string{Namespace, t.GroupId, string(t.MessageType), t.EdgeNodeId}
+	if t.DeviceId != "" {
+		elements = append(elements, t.DeviceId)
+	}
+	return strings.Join(elements, topicSeparator)
+}
+
+// NodeId returns the edge node descriptor {GroupId}/{EdgeNodeId} identifying the edge node across groups
+func (t Topic) NodeId() string {
+	return t.GroupId + topicSeparator + t.EdgeNodeId
+}
+
+// ParseNodeId splits the edge node descriptor returned by NodeId into the group id and edge node id
+func ParseNodeId(nodeId string) (groupId, edgeNodeId string, err error) {
+	groupId, edgeNodeId, found := strings.Cut(nodeId, topicSeparator)
+	if !found {
+		return "", "", fmt.Errorf("node id '%s' is not in the form {group_id}/{edge_node_id}", nodeId)
+	}
+	if err = validateTopicId("group id", groupId); err != nil {
+		return "", "", err
+	}
+	if err = validateTopicId("edge node id", edgeNodeId); err != nil {
+		return "", "", err
+	}
+	return groupId, edgeNodeId, nil
+}
+
+// AssociationTriple returns the node id, device name and metric name of the metric published on the topic,
+// as used by AlarmClient.AddSparkplugAssociation
+// The device name is empty for the metrics published by the edge node itself
+func (t Topic) AssociationTriple(metricName string) (nodeId, deviceName, metric string) {
+	return t.NodeId(), t.DeviceId, metricName
+}
+
+// AlarmAssociation returns the Sparkplug alarm association of the metric published on the topic
+func (t Topic) AlarmAssociation(metricName, configName string) models.AlarmAssociation {
+	nodeId, deviceName, metric := t.AssociationTriple(metricName)
+	return models.AlarmAssociation{
+		SourceType:          common.AlarmSourceTypeSparkplug,
+		ConfigName:          configName,
+		SparkplugNodeId:     nodeId,
+		SparkplugDeviceName: deviceName,
+		SparkplugMetricName: metric,
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopic(t *testing.T) {
+	tests := []struct {
+		name     string
+		topic    string
+		expected Topic
+	}{
+		{"NBIRTH", "spBv1.0/group/NBIRTH/node", Topic{GroupId: "group", MessageType: MessageTypeNBIRTH, EdgeNodeId: "node"}},
+		{"NDEATH", "spBv1.0/group/NDEATH/node", Topic{GroupId: "group", MessageType: MessageTypeNDEATH, EdgeNodeId: "node"}},
+		{"NDATA", "spBv1.0/group/NDATA/node", Topic{GroupId: "group", MessageType: MessageTypeNDATA, EdgeNodeId: "node"}},
+		{"NCMD", "spBv1.0/group/NCMD/node", Topic{GroupId: "group", MessageType: MessageTypeNCMD, EdgeNodeId: "node"}},
+		{"DBIRTH", "spBv1.0/group/DBIRTH/node/device", Topic{GroupId: "group", MessageType: MessageTypeDBIRTH, EdgeNodeId: "node", DeviceId: "device"}},
+		{"DDEATH", "spBv1.0/group/DDEATH/node/device", Topic{GroupId: "group", MessageType: MessageTypeDDEATH, EdgeNodeId: "node", DeviceId: "device"}},
+		{"DDATA", "spBv1.0/group/DDATA/node/device", Topic{GroupId: "group", MessageType: MessageTypeDDATA, EdgeNodeId: "node", DeviceId: "device"}},
+		{"DCMD", "spBv1.0/group/DCMD/node/device", Topic{GroupId: "group", MessageType: MessageTypeDCMD, EdgeNodeId: "node", DeviceId: "device"}},
+		{"STATE", "spBv1.0/STATE/host", Topic{MessageType: MessageTypeSTATE, HostId: "host"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ParseTopic(testCase.topic)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+			assert.Equal(t, testCase.topic, result.String())
+		})
+	}
+}
+
+func TestParseTopic_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		topic string
+	}{
+		{"invalid namespace", "spAv1.0/group/NBIRTH/node"},
+		{"too few elements", "spBv1.0/group/NBIRTH"},
+		{"too many elements", "spBv1.0/group/DDATA/node/device/extra"},
+		{"unknown message type", "spBv1.0/group/NINFO/node"},
+		{"node message with device", "spBv1.0/group/NDATA/node/device"},
+		{"device message without device", "spBv1.0/group/DDATA/node"},
+		{"empty group", "spBv1.0//NDATA/node"},
+		{"empty device", "spBv1.0/group/DDATA/node/"},
+		{"wildcard", "spBv1.0/+/NDATA/node"},
+		{"STATE with group", "spBv1.0/group/STATE/host"},
+		{"empty host", "spBv1.0/STATE/"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseTopic(testCase.topic)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewTopic(t *testing.T) {
+	topic, err := NewNodeTopic("group", MessageTypeNCMD, "node")
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/group/NCMD/node", topic.String())
+
+	topic, err = NewDeviceTopic("group", MessageTypeDCMD, "node", "device")
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/group/DCMD/node/device", topic.String())
+
+	topic, err = NewStateTopic("host")
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/STATE/host", topic.String())
+
+	_, err = NewNodeTopic("group", MessageTypeDCMD, "node")
+	require.Error(t, err)
+	_, err = NewDeviceTopic("group", MessageTypeNCMD, "node", "device")
+	require.Error(t, err)
+	_, err = NewDeviceTopic("group", MessageTypeDCMD, "node", "dev#ice")
+	require.Error(t, err)
+}
+
+func TestNodeId(t *testing.T) {
+	topic, err := ParseTopic("spBv1.0/group/DDATA/node/device")
+	require.NoError(t, err)
+	assert.Equal(t, "group/node", topic.NodeId())
+
+	groupId, edgeNodeId, err := ParseNodeId(topic.NodeId())
+	require.NoError(t, err)
+	assert.Equal(t, "group", groupId)
+	assert.Equal(t, "node", edgeNodeId)
+
+	_, _, err = ParseNodeId("node")
+	require.Error(t, err)
+	_, _, err = ParseNodeId("group/node/device")
+	require.Error(t, err)
+}
+
+func TestAlarmAssociation(t *testing.T) {
+	deviceTopic, err := ParseTopic("spBv1.0/group/DDATA/node/device")
+	require.NoError(t, err)
+	nodeId, deviceName, metricName := deviceTopic.AssociationTriple("temperature")
+	assert.Equal(t, "group/node", nodeId)
+	assert.Equal(t, "device", deviceName)
+	assert.Equal(t, "temperature", metricName)
+
+	expected := models.AlarmAssociation{
+		SourceType:          common.AlarmSourceTypeSparkplug,
+		ConfigName:          "config",
+		SparkplugNodeId:     "group/node",
+		SparkplugDeviceName: "device",
+		SparkplugMetricName: "temperature",
+	}
+	assert.Equal(t, expected, deviceTopic.AlarmAssociation("temperature", "config"))
+
+	nodeTopic, err := ParseTopic("spBv1.0/group/NDATA/node")
+	require.NoError(t, err)
+	association := nodeTopic.AlarmAssociation("uptime", "config")
+	assert.Equal(t, "group/node", association.SparkplugNodeId)
+	assert.Empty(t, association.SparkplugDeviceName)
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package sparkplug
+
+import (
+	"fmt"
+
+	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/sparkplug/protobuf"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// dataTypeToValueType maps the Sparkplug metric DataType to the EdgeX reading value type
+// DateTime values are milliseconds since the Unix epoch and are carried as Uint64 in EdgeX,
+// while Templates and DataSets are carried as Object, see ConvertTemplateToObject and ConvertDataSetToObject
+var dataTypeToValueType = map[protobuf.DataType]string{
+	protobuf.DataType_Int8:          common.ValueTypeInt8,
+	protobuf.DataType_Int16:         common.ValueTypeInt16,
+	protobuf.DataType_Int32:         common.ValueTypeInt32,
+	protobuf.DataType_Int64:         common.ValueTypeInt64,
+	protobuf.DataType_UInt8:         common.ValueTypeUint8,
+	protobuf.DataType_UInt16:        common.ValueTypeUint16,
+	protobuf.DataType_UInt32:        common.ValueTypeUint32,
+	protobuf.DataType_UInt64:        common.ValueTypeUint64,
+	protobuf.DataType_Float:         common.ValueTypeFloat32,
+	protobuf.DataType_Double:        common.ValueTypeFloat64,
+	protobuf.DataType_Boolean:       common.ValueTypeBool,
+	protobuf.DataType_String:        common.ValueTypeString,
+	protobuf.DataType_DateTime:      common.ValueTypeUint64,
+	protobuf.DataType_Text:          common.ValueTypeString,
+	protobuf.DataType_UUID:          common.ValueTypeString,
+	protobuf.DataType_Bytes:         common.ValueTypeBinary,
+	protobuf.DataType_File:          common.ValueTypeBinary,
+	protobuf.DataType_DataSet:       common.ValueTypeObject,
+	protobuf.DataType_Template:      common.ValueTypeObject,
+	protobuf.DataType_Int8Array:     common.ValueTypeInt8Array,
+	protobuf.DataType_Int16Array:    common.ValueTypeInt16Array,
+	protobuf.DataType_Int32Array:    common.ValueTypeInt32Array,
+	protobuf.DataType_Int64Array:    common.ValueTypeInt64Array,
+	protobuf.DataType_UInt8Array:    common.ValueTypeUint8Array,
+	protobuf.DataType_UInt16Array:   common.ValueTypeUint16Array,
+	protobuf.DataType_UInt32Array:   common.ValueTypeUint32Array,
+	protobuf.DataType_UInt64Array:   common.ValueTypeUint64Array,
+	protobuf.DataType_FloatArray:    common.ValueTypeFloat32Array,
+	protobuf.DataType_DoubleArray:   common.ValueTypeFloat64Array,
+	protobuf.DataType_BooleanArray:  common.ValueTypeBoolArray,
+	protobuf.DataType_StringArray:   common.ValueTypeStringArray,
+	protobuf.DataType_DateTimeArray: common.ValueTypeUint64Array,
+}
+
+// valueTypeToDataType maps the EdgeX reading value type to the Sparkplug metric DataType
+// Object readings in the DataSet shape are published as DataSet by ConvertReadingToMetric
+var valueTypeToDataType = map[string]protobuf.DataType{
+	common.ValueTypeInt8:         protobuf.DataType_Int8,
+	common.ValueTypeInt16:        protobuf.DataType_Int16,
+	common.ValueTypeInt32:        protobuf.DataType_Int32,
+	common.ValueTypeInt64:        protobuf.DataType_Int64,
+	common.ValueTypeUint8:        protobuf.DataType_UInt8,
+	common.ValueTypeUint16:       protobuf.DataType_UInt16,
+	common.ValueTypeUint32:       protobuf.DataType_UInt32,
+	common.ValueTypeUint64:       protobuf.DataType_UInt64,
+	common.ValueTypeFloat32:      protobuf.DataType_Float,
+	common.ValueTypeFloat64:      protobuf.DataType_Double,
+	common.ValueTypeBool:         protobuf.DataType_Boolean,
+	common.ValueTypeString:       protobuf.DataType_String,
+	common.ValueTypeBinary:       protobuf.DataType_Bytes,
+	common.ValueTypeObject:       protobuf.DataType_Template,
+	common.ValueTypeInt8Array:    protobuf.DataType_Int8Array,
+	common.ValueTypeInt16Array:   protobuf.DataType_Int16Array,
+	common.ValueTypeInt32Array:   protobuf.DataType_Int32Array,
+	common.ValueTypeInt64Array:   protobuf.DataType_Int64Array,
+	common.ValueTypeUint8Array:   protobuf.DataType_UInt8Array,
+	common.ValueTypeUint16Array:  protobuf.DataType_UInt16Array,
+	common.ValueTypeUint32Array:  protobuf.DataType_UInt32Array,
+	common.ValueTypeUint64Array:  protobuf.DataType_UInt64Array,
+	common.ValueTypeFloat32Array: protobuf.DataType_FloatArray,
+	common.ValueTypeFloat64Array: protobuf.DataType_DoubleArray,
+	common.ValueTypeBoolArray:    protobuf.DataType_BooleanArray,
+	common.ValueTypeStringArray:  protobuf.DataType_StringArray,
+}
+
+// ToEdgeXValueType returns the EdgeX value type of the given Sparkplug DataType
+func ToEdgeXValueType(dataType protobuf.DataType) (string, error) {
+	valueType, ok := dataTypeToValueType[dataType]
+	if !ok {
+		return "", fmt.Errorf("unsupported Sparkplug DataType '%s'", dataType)
+	}
+	return valueType, nil
+}
+
+// ToSparkplugDataType returns the Sparkplug DataType of the given EdgeX value type
+func ToSparkplugDataType(valueType string) (protobuf.DataType, error) {
+	normalized, err := common.NormalizeValueType(valueType)
+	if err != nil {
+		return protobuf.DataType_Unknown, err
+	}
+	dataType, ok := valueTypeToDataType[normalized]
+	if !ok {
+		return protobuf.DataType_Unknown, fmt.Errorf("unsupported EdgeX value type '%s'", valueType)
+	}
+	return dataType, nil
+}
+
+// isArrayDataType checks if the given DataType is one of the packed array types carried in bytes_value
+func isArrayDataType(dataType protobuf.DataType) bool {
+	return dataType >= protobuf.DataType_Int8Array && dataType <= protobuf.DataType_DateTimeArray
+}
@@ -2,6 +2,14 @@
 
 package xrtmodels
 
+import (
+	"fmt"
+	"time"
+
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
 // Schedule is used to register timed, polled reads of device resources
 // The definition can refer to https://github.com/IOTechSystems/xrt-docs/blob/v2.0-branch/docs/mqtt-management/mqtt-management.md#schedule-format
 type Schedule struct {
@@ -16,3 +24,38 @@ type Schedule struct {
 	Units    bool               `json:"units"`
 	Options  any                `json:"options,omitempty"`
 }
+
+// NewSchedulesFromAutoEvents converts the AutoEvents of the Device to the published Schedules, e.g. the AutoEvents of
+// the Devices converted from DBC files
+// The AutoEvents with the same interval and OnChange are merged into one Schedule of their source resources
+func NewSchedulesFromAutoEvents(device edgexDtos.Device) ([]Schedule, errors.EdgeX) {
+	var schedules []Schedule
+	for _, autoEvent := range device.AutoEvents {
+		duration, err := time.ParseDuration(autoEvent.Interval)
+		if err != nil || duration < 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid interval '%s' of the AutoEvent of device '%s'", autoEvent.Interval, device.Name), err)
+		}
+		interval := uint64(duration.Microseconds()) // #nosec G115
+
+		merged := false
+		for i := range schedules {
+			if schedules[i].Interval == interval && schedules[i].OnChange == autoEvent.OnChange {
+				schedules[i].Resource = append(schedules[i].Resource, autoEvent.SourceName)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			schedules = append(schedules, Schedule{
+				Name:     fmt.Sprintf("%s-schedule-%d", device.Name, len(schedules)+1),
+				Device:   device.Name,
+				Resource: []string{autoEvent.SourceName},
+				Interval: interval,
+				OnChange: autoEvent.OnChange,
+				Publish:  true,
+			})
+		}
+	}
+	return schedules, nil
+}
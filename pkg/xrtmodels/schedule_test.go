@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"testing"
 
+	edgexDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -38,3 +40,27 @@ func TestSchedule_TagsOmittedWhenNil(t *testing.T) {
 
 	assert.NotContains(t, string(data), `"tags"`)
 }
+
+func TestNewSchedulesFromAutoEvents(t *testing.T) {
+	device := edgexDtos.Device{
+		Name: "EEC2",
+		AutoEvents: []edgexDtos.AutoEvent{
+			{Interval: "100ms", SourceName: "Speed"},
+			{Interval: "1s", OnChange: true, SourceName: "Gear"},
+			{Interval: "100ms", SourceName: "Torque"},
+		},
+	}
+	schedules, err := NewSchedulesFromAutoEvents(device)
+	require.NoError(t, err)
+	assert.Equal(t, []Schedule{
+		{Name: "EEC2-schedule-1", Device: "EEC2", Resource: []string{"Speed", "Torque"}, Interval: 100000, Publish: true},
+		{Name: "EEC2-schedule-2", Device: "EEC2", Resource: []string{"Gear"}, Interval: 1000000, OnChange: true, Publish: true},
+	}, schedules)
+
+	schedules, err = NewSchedulesFromAutoEvents(edgexDtos.Device{Name: "device"})
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	_, err = NewSchedulesFromAutoEvents(edgexDtos.Device{Name: "device", AutoEvents: []edgexDtos.AutoEvent{{Interval: "fast", SourceName: "Speed"}}})
+	require.Error(t, err)
+}
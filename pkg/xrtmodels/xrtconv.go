@@ -1,4 +1,4 @@
-// Copyright (C) 2022-2025 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 
 package xrtmodels
 
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/central/dbc"
 	"github.com/IOTechSystems/go-mod-central-ext/v4/pkg/common"
 
 	edgexCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -88,8 +87,8 @@ func PropertyConversionList(protocol string) ([]string, []string, []string) {
 	case common.EtherNetIPKey:
 		intProperties = []string{common.EtherNetIPVendorID, common.EtherNetIPDeviceType, common.EtherNetIPProductCode,
 			common.EtherNetIPMajorRevision, common.EtherNetIPMinorRevision}
-	case dbc.Canbus:
-		intProperties = []string{dbc.ID, dbc.DataSize, dbc.Port}
+	case common.Canbus:
+		intProperties = []string{common.CanbusID, common.CanbusDataSize, common.CanbusPort}
 	}
 	return intProperties, floatProperties, boolProperties
 }